@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// supportedAPISchemaVersion is the newest response schema version this provider understands.
+const supportedAPISchemaVersion = 1
+
+// Response headers the backend uses to announce schema changes.
+const (
+	// schemaVersionHeader carries the response schema version, e.g. "2".
+	schemaVersionHeader = "X-Bugx-Schema-Version"
+	// fieldAliasesHeader announces renamed fields as "NewName=OldName" pairs separated by commas.
+	fieldAliasesHeader = "X-Bugx-Field-Aliases"
+)
+
+// newerSchemaWarning makes sure the newer-schema warning is only logged once per run.
+var newerSchemaWarning sync.Once
+
+// decodeAPIResponse decodes a JSON response body into v, tolerating API evolution:
+// unknown fields are ignored, missing fields keep their zero value, an empty body
+// decodes to nothing, and fields renamed through fieldAliasesHeader are mapped back to
+// the names the provider's structs use.
+func decodeAPIResponse(resp *http.Response, v interface{}) error {
+	checkAPISchemaVersion(resp)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	aliases := parseFieldAliases(resp.Header.Get(fieldAliasesHeader))
+	if len(aliases) > 0 {
+		var raw interface{}
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return err
+		}
+		if body, err = json.Marshal(renameJSONFields(raw, aliases)); err != nil {
+			return err
+		}
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// parseFieldAliases parses "NewName=OldName, Other=Legacy" into a new-to-old name map.
+func parseFieldAliases(header string) map[string]string {
+	aliases := map[string]string{}
+	for _, pair := range strings.Split(header, ",") {
+		newName, oldName, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || newName == "" || oldName == "" {
+			continue
+		}
+		aliases[strings.TrimSpace(newName)] = strings.TrimSpace(oldName)
+	}
+	return aliases
+}
+
+// renameJSONFields renames object keys throughout a decoded JSON value. A key is left
+// alone when the object already carries the old name, so explicit values win.
+func renameJSONFields(v interface{}, aliases map[string]string) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, child := range val {
+			out[k] = renameJSONFields(child, aliases)
+		}
+		for newName, oldName := range aliases {
+			child, ok := out[newName]
+			if !ok {
+				continue
+			}
+			if _, exists := out[oldName]; !exists {
+				out[oldName] = child
+				delete(out, newName)
+			}
+		}
+		return out
+	case []interface{}:
+		for i, child := range val {
+			val[i] = renameJSONFields(child, aliases)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// apiSchemaVersion returns the schema version reported by resp, or 0 if none.
+func apiSchemaVersion(resp *http.Response) int {
+	version, err := strconv.Atoi(strings.TrimSpace(resp.Header.Get(schemaVersionHeader)))
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// checkAPISchemaVersion logs a warning the first time the API reports a schema newer
+// than the provider supports.
+func checkAPISchemaVersion(resp *http.Response) {
+	if version := apiSchemaVersion(resp); version > supportedAPISchemaVersion {
+		newerSchemaWarning.Do(func() {
+			log.Printf("[WARN] bugx API reports response schema version %d, this provider supports up to %d; consider upgrading the provider", version, supportedAPISchemaVersion)
+		})
+	}
+}
+
+// apiSchemaVersionDiags returns a warning when resp reports a schema version newer
+// than the provider supports.
+func apiSchemaVersionDiags(resp *http.Response) diag.Diagnostics {
+	version := apiSchemaVersion(resp)
+	if version <= supportedAPISchemaVersion {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "bugx API is newer than this provider",
+			Detail:   fmt.Sprintf("The API reports response schema version %d, but this provider release supports up to version %d. Unknown fields are ignored, but new features may be missing; consider upgrading the provider.", version, supportedAPISchemaVersion),
+		},
+	}
+}
+
+// API compatibility modes, selected with the provider's api_compatibility argument.
+const (
+	// apiCompatAuto accepts every list shape seen across backend versions.
+	apiCompatAuto = "auto"
+	// apiCompatStrict only accepts the shape each endpoint documents and fails otherwise.
+	apiCompatStrict = "strict"
+)
+
+// decodeAPIList decodes a list response into out, a pointer to a slice. Depending on
+// the backend version, a list arrives as a JSON array, as a single object, or wrapped
+// in an envelope such as {"items": [...]}. envelope names the endpoint's documented
+// envelope field, or is empty if the endpoint documents a plain array. identity lists
+// the fields that identify an item; a single object is only taken as a one-element list
+// if it carries one of them, so that an error body served with 200 is not mistaken for
+// an item. In strict mode only the documented shape is accepted.
+func decodeAPIList(resp *http.Response, mode, envelope string, identity []string, out interface{}) error {
+	var raw json.RawMessage
+	if err := decodeAPIResponse(resp, &raw); err != nil {
+		return err
+	}
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	items, field, err := normalizeAPIList(raw, envelope, identity)
+	if err != nil {
+		return err
+	}
+	if mode == apiCompatStrict && field != envelope {
+		expected := "a JSON array"
+		if envelope != "" {
+			expected = fmt.Sprintf("an object with a %q list", envelope)
+		}
+		return fmt.Errorf("unexpected response shape from %s: expected %s (set api_compatibility = %q to accept other shapes)",
+			resp.Request.URL.Path, expected, apiCompatAuto)
+	}
+	return json.Unmarshal(items, out)
+}
+
+// normalizeAPIList turns a list response of any supported shape into a JSON array. It
+// also returns the envelope field the list was found in: "" for a plain array, and "."
+// for a single object that was wrapped into a one-element list.
+func normalizeAPIList(raw json.RawMessage, envelope string, identity []string) (json.RawMessage, string, error) {
+	switch raw[0] {
+	case '[':
+		return raw, "", nil
+	case '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, "", err
+		}
+		for _, key := range []string{envelope, "items"} {
+			if key == "" {
+				continue
+			}
+			if list, ok := fields[key]; ok {
+				list = bytes.TrimSpace(list)
+				if string(list) == "null" {
+					return json.RawMessage("[]"), key, nil
+				}
+				if len(list) > 0 && list[0] == '[' {
+					return list, key, nil
+				}
+			}
+		}
+		// An empty object means nothing was found.
+		if len(fields) == 0 {
+			return json.RawMessage("[]"), ".", nil
+		}
+		for _, key := range identity {
+			if v, ok := fields[key]; ok && string(bytes.TrimSpace(v)) != "null" {
+				wrapped := append(append([]byte{'['}, raw...), ']')
+				return wrapped, ".", nil
+			}
+		}
+		return nil, "", fmt.Errorf("unexpected list response: object without any of %s: %.80s", strings.Join(identity, ", "), string(raw))
+	default:
+		return nil, "", fmt.Errorf("unexpected list response: %.40s", string(raw))
+	}
+}
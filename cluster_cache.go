@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// clusterCache holds the /clusters list for a short time, so that refreshing a
+// workspace with many clusters and Helm releases issues one list request instead
+// of one per resource. Any non-GET API request invalidates it.
+type clusterCache struct {
+	ttl time.Duration
+
+	// fetchMu serializes cache misses so concurrent readers share one request.
+	fetchMu sync.Mutex
+
+	mu         sync.Mutex
+	list       []ClusterInfo
+	fetchedAt  time.Time
+	generation uint64
+}
+
+// freshClustersKey marks a context whose cluster lookups must bypass the cache.
+type freshClustersKey struct{}
+
+// withFreshClusters returns a context whose cluster lookups bypass the cache, for
+// polling loops that wait for a status to change.
+func withFreshClusters(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freshClustersKey{}, true)
+}
+
+// wantsFreshClusters reports whether ctx was marked by withFreshClusters.
+func wantsFreshClusters(ctx context.Context) bool {
+	fresh, _ := ctx.Value(freshClustersKey{}).(bool)
+	return fresh
+}
+
+// load returns the cached list, or calls fetch and caches its result when the
+// cache is empty, expired or bypassed by ctx.
+func (c *clusterCache) load(ctx context.Context, fetch func(context.Context) ([]ClusterInfo, error)) ([]ClusterInfo, error) {
+	if !wantsFreshClusters(ctx) {
+		if list, ok := c.get(); ok {
+			return list, nil
+		}
+	}
+
+	c.fetchMu.Lock()
+	defer c.fetchMu.Unlock()
+
+	// Another caller may have filled the cache while we waited.
+	if !wantsFreshClusters(ctx) {
+		if list, ok := c.get(); ok {
+			return list, nil
+		}
+	}
+
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	list, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	// Do not store a list fetched before a write invalidated the cache.
+	if generation == c.generation {
+		c.list = list
+		c.fetchedAt = time.Now()
+	}
+	c.mu.Unlock()
+	return list, nil
+}
+
+// get returns the cached list if it has not expired.
+func (c *clusterCache) get() ([]ClusterInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fetchedAt.IsZero() || time.Since(c.fetchedAt) > c.ttl {
+		return nil, false
+	}
+	return c.list, true
+}
+
+// invalidate drops the cached list.
+func (c *clusterCache) invalidate() {
+	c.mu.Lock()
+	c.list = nil
+	c.fetchedAt = time.Time{}
+	c.generation++
+	c.mu.Unlock()
+}
+
+// clusterCacheTransport invalidates the cluster cache on every request that may
+// change server-side state.
+type clusterCacheTransport struct {
+	base  http.RoundTripper
+	cache *clusterCache
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *clusterCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		t.cache.invalidate()
+	}
+	return resp, err
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"log"
+	"net/http"
+	"sync/atomic"
+)
+
+// gzipMinRequestSize is the smallest request body that is sent gzip-compressed.
+// Smaller bodies, which are most requests, are not worth the CPU time.
+const gzipMinRequestSize = 32 << 10
+
+// gzipTransport compresses large request bodies, such as multi-megabyte Helm values,
+// with Content-Encoding: gzip. If the API answers 415 Unsupported Media Type, the
+// request is resent uncompressed and compression is turned off for the session.
+//
+// Responses need no handling here: http.Transport already sends Accept-Encoding: gzip
+// and transparently decompresses gzip responses such as large kubeconfigs.
+type gzipTransport struct {
+	base     http.RoundTripper
+	disabled atomic.Bool
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *gzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Encoding") != "" || t.disabled.Load() {
+		return t.base.RoundTrip(req)
+	}
+
+	var (
+		body []byte
+		err  error
+	)
+	if req.GetBody != nil {
+		var rc io.ReadCloser
+		if rc, err = req.GetBody(); err == nil {
+			body, err = io.ReadAll(rc)
+			rc.Close()
+		}
+		req.Body.Close()
+	} else {
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plain := req.Clone(req.Context())
+	plain.Body = io.NopCloser(bytes.NewReader(body))
+	plain.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	plain.ContentLength = int64(len(body))
+	if len(body) < gzipMinRequestSize {
+		return t.base.RoundTrip(plain)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	compressed := buf.Bytes()
+
+	gz := req.Clone(req.Context())
+	gz.Body = io.NopCloser(bytes.NewReader(compressed))
+	gz.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	gz.ContentLength = int64(len(compressed))
+	gz.Header.Set("Content-Encoding", "gzip")
+
+	resp, err := t.base.RoundTrip(gz)
+	if err != nil || resp.StatusCode != http.StatusUnsupportedMediaType {
+		return resp, err
+	}
+
+	log.Printf("[WARN] API does not accept gzip-compressed requests, sending request bodies uncompressed")
+	t.disabled.Store(true)
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return t.base.RoundTrip(plain)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+)
+
+// conditionalGetPaths are the read endpoints whose responses are revalidated with
+// If-None-Match instead of being transferred in full on every refresh.
+var conditionalGetPaths = map[string]bool{
+	"/clusters":               true,
+	"/secrets/api/v1/secrets": true,
+}
+
+// etagEntry is a response body remembered together with the ETag it was served with.
+type etagEntry struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// conditionalGetTransport sends If-None-Match for GET requests to conditionalGetPaths
+// and answers a 304 Not Modified with the body it stored for the same URL, so callers
+// always see a complete 200 response.
+type conditionalGetTransport struct {
+	base http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]*etagEntry
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *conditionalGetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || !conditionalGetPaths[req.URL.Path] || req.Header.Get("If-None-Match") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// The token is part of the key so that one identity never sees another's cached list.
+	key := req.Header.Get("Authorization") + " " + req.URL.String()
+
+	t.mu.Lock()
+	cached := t.entries[key]
+	t.mu.Unlock()
+
+	if cached != nil {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		log.Printf("[DEBUG] %s not modified, using cached response", req.URL.Path)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		header := cached.header.Clone()
+		for k, v := range resp.Header {
+			switch http.CanonicalHeaderKey(k) {
+			case "Content-Length", "Content-Encoding", "Transfer-Encoding":
+			default:
+				header[k] = v
+			}
+		}
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Header = header
+		resp.Body = io.NopCloser(bytes.NewReader(cached.body))
+		resp.ContentLength = int64(len(cached.body))
+		return resp, nil
+
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		t.mu.Lock()
+		if t.entries == nil {
+			t.entries = make(map[string]*etagEntry)
+		}
+		t.entries[key] = &etagEntry{etag: resp.Header.Get("ETag"), header: resp.Header.Clone(), body: body}
+		t.mu.Unlock()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, nil
+	}
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAPIDiagnostics defines a data source exposing API quota information
+// collected from rate-limit response headers
+func dataSourceAPIDiagnostics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAPIDiagnosticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_quota_remaining": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Requests remaining in the current rate-limit window, as last reported by the API (-1 if the API has not reported a quota)",
+			},
+			"api_quota_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total requests allowed per rate-limit window (0 if not reported)",
+			},
+			"api_quota_reset_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "RFC3339 timestamp when the rate-limit window resets, empty if not reported",
+			},
+			"responses_observed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of API responses that carried rate-limit headers so far in this run",
+			},
+		},
+	}
+}
+
+// dataSourceAPIDiagnosticsRead reports the latest quota observed by the provider
+func dataSourceAPIDiagnosticsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	var snap rateLimitSnapshot
+	if client.RateLimit != nil {
+		snap = client.RateLimit.snapshot()
+	}
+
+	remaining := -1
+	resetAt := ""
+	if snap.Known {
+		remaining = snap.Remaining
+		if !snap.Reset.IsZero() {
+			resetAt = snap.Reset.Format(time.RFC3339)
+		}
+	}
+
+	d.SetId(client.BaseURL)
+
+	if err := d.Set("api_quota_remaining", remaining); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("api_quota_limit", snap.Limit); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("api_quota_reset_at", resetAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("responses_observed", snap.Observed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
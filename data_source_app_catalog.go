@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CatalogEntry represents an app returned from the catalog apps API.
+type CatalogEntry struct {
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	Category         string   `json:"category"`
+	Versions         []string `json:"versions"` // newest first
+	LatestVersion    string   `json:"latestVersion"`
+	DefaultNamespace string   `json:"defaultNamespace"`
+}
+
+// dataSourceAppCatalog defines a data source listing the apps and versions available in the platform catalog
+func dataSourceAppCatalog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppCatalogRead,
+
+		Schema: map[string]*schema.Schema{
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return apps in this category (e.g., 'databases', 'monitoring')",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only return apps whose name matches this regular expression",
+			},
+			"apps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Catalog apps matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":              {Type: schema.TypeString, Computed: true, Description: "Name of the app, as used in bugx_app's app"},
+						"description":       {Type: schema.TypeString, Computed: true, Description: "Description of the app"},
+						"category":          {Type: schema.TypeString, Computed: true, Description: "Category of the app"},
+						"latest_version":    {Type: schema.TypeString, Computed: true, Description: "Version installed when bugx_app's version is empty"},
+						"default_namespace": {Type: schema.TypeString, Computed: true, Description: "Namespace the app is installed into by default"},
+						"versions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Available versions, newest first",
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the apps matching the filters",
+			},
+			"latest_versions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Latest version of each app matching the filters, keyed by app name",
+			},
+		},
+	}
+}
+
+// dataSourceAppCatalogRead queries /catalog/api/v1/apps and applies the filters
+func dataSourceAppCatalogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	category := d.Get("category").(string)
+
+	var nameRegex *regexp.Regexp
+	if expr := d.Get("name_regex").(string); expr != "" {
+		nameRegex = regexp.MustCompile(expr)
+	}
+
+	var all []CatalogEntry
+	if err := fetchList(ctx, client, fmt.Sprintf("%s/catalog/api/v1/apps", client.BaseURL), "catalog apps", &all); err != nil {
+		return diag.FromErr(err)
+	}
+
+	apps := make([]map[string]interface{}, 0, len(all))
+	names := make([]string, 0, len(all))
+	latest := make(map[string]string, len(all))
+	for _, a := range all {
+		if category != "" && a.Category != category {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(a.Name) {
+			continue
+		}
+		apps = append(apps, map[string]interface{}{
+			"name":              a.Name,
+			"description":       a.Description,
+			"category":          a.Category,
+			"latest_version":    a.LatestVersion,
+			"default_namespace": a.DefaultNamespace,
+			"versions":          a.Versions,
+		})
+		names = append(names, a.Name)
+		latest[a.Name] = a.LatestVersion
+	}
+
+	d.SetId(fmt.Sprintf("%s/catalog/api/v1/apps", client.BaseURL))
+
+	if err := d.Set("apps", apps); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("latest_versions", latest); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceApps defines a data source listing the app records known to the backend
+func dataSourceApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return apps of this bugx cluster. If empty, apps of every cluster are returned",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return apps in this Kubernetes namespace",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return apps with this status",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only return apps whose name matches this regular expression",
+			},
+			"apps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Apps matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":         {Type: schema.TypeString, Computed: true, Description: "App name, as accepted by /deleteapp"},
+						"cluster_name": {Type: schema.TypeString, Computed: true, Description: "Cluster the app belongs to"},
+						"release":      {Type: schema.TypeString, Computed: true, Description: "Helm release backing the app"},
+						"namespace":    {Type: schema.TypeString, Computed: true, Description: "Kubernetes namespace of the release"},
+						"chart":        {Type: schema.TypeString, Computed: true, Description: "Chart the release was installed from"},
+						"status":       {Type: schema.TypeString, Computed: true, Description: "App status reported by the backend"},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the apps matching the filters",
+			},
+		},
+	}
+}
+
+// dataSourceAppsRead queries /apps and applies the filters
+func dataSourceAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	clustername := d.Get("cluster_name").(string)
+	namespace := d.Get("namespace").(string)
+	status := d.Get("status").(string)
+
+	var nameRegex *regexp.Regexp
+	if expr := d.Get("name_regex").(string); expr != "" {
+		nameRegex = regexp.MustCompile(expr)
+	}
+
+	all, err := fetchApps(ctx, client, clustername)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apps := make([]map[string]interface{}, 0, len(all))
+	names := make([]string, 0, len(all))
+	for _, a := range all {
+		if namespace != "" && a.Namespace != namespace {
+			continue
+		}
+		if status != "" && a.Status != status {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(a.Name) {
+			continue
+		}
+		cluster := a.Clustername
+		if cluster == "" {
+			cluster = clustername
+		}
+		apps = append(apps, map[string]interface{}{
+			"name":         a.Name,
+			"cluster_name": cluster,
+			"release":      a.Release,
+			"namespace":    a.Namespace,
+			"chart":        a.Chart,
+			"status":       a.Status,
+		})
+		names = append(names, a.Name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/apps/%s:%s", client.BaseURL, clustername, namespace))
+
+	if err := d.Set("apps", apps); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,11 +38,36 @@ func dataSourceCluster() *schema.Resource {
 				Computed:    true,
 				Description: "Kubernetes namespace where the cluster is deployed",
 			},
+			"health_check": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest health check result reported for the cluster",
+			},
+			"alert": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current alert state of the cluster",
+			},
 			"version": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Platform version of the cluster",
 			},
+			"creation_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the cluster was created",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User that created the cluster",
+			},
+			"last_modified": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the cluster was last modified",
+			},
 			"kubeconfig": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -95,12 +119,29 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 	if err := d.Set("version", info.Version); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("health_check", info.HealthCheck); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("alert", info.Alert); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("creation_timestamp", info.CreationTimestamp); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_by", info.CreatedBy); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_modified", info.LastModified); err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Fetch kubeconfig if cluster is healthy
 	if info.Status == "Healthy" {
 		kubeconfig, err := fetchKubeconfig(ctx, client, name)
 		if err != nil {
-			log.Printf("[WARN] failed to fetch kubeconfig for cluster %s: %v", name, err)
+			if diags := client.softFailure("failed to fetch kubeconfig for cluster %s: %v", name, err); diags.HasError() {
+				return diags
+			}
 		} else if kubeconfig != "" {
 			if err := d.Set("kubeconfig", kubeconfig); err != nil {
 				return diag.FromErr(err)
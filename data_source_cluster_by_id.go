@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceClusterByID defines a data source to query an existing cluster by its cluster ID.
+// It exports the same attributes as bugx_cluster.
+func dataSourceClusterByID() *schema.Resource {
+	r := dataSourceCluster()
+	r.ReadContext = dataSourceClusterByIDRead
+	r.Schema["cluster_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "ID of the bugx cluster to query, as it appears in audit logs",
+	}
+	r.Schema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the bugx cluster",
+	}
+	return r
+}
+
+// dataSourceClusterByIDRead resolves the cluster ID to a name through /clusters and reads it like bugx_cluster
+func dataSourceClusterByIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	id := d.Get("cluster_id").(string)
+	if id == "" {
+		return diag.Errorf("cluster_id is required")
+	}
+
+	allClusters, err := fetchAllClusters(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := ""
+	for _, cluster := range allClusters {
+		if cluster.ClusterID == id {
+			name = cluster.Name
+			break
+		}
+	}
+	if name == "" {
+		return diag.Errorf("cluster with ID '%s' not found", id)
+	}
+
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	return dataSourceClusterRead(ctx, d, m)
+}
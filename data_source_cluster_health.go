@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ClusterHealthCheck represents one health check result returned from /cluster_health.
+type ClusterHealthCheck struct {
+	Name        string `json:"Name"`
+	Status      string `json:"Status"` // "Passing", "Warning" or "Failing"
+	Message     string `json:"Message"`
+	LastChecked string `json:"LastChecked"`
+}
+
+// ClusterAlert represents an active alert returned from /cluster_health.
+type ClusterAlert struct {
+	Name     string `json:"Name"`
+	Severity string `json:"Severity"` // "info", "warning" or "critical"
+	Message  string `json:"Message"`
+	Since    string `json:"Since"`
+}
+
+// ClusterHealthResponse represents the JSON structure returned from /cluster_health.
+type ClusterHealthResponse struct {
+	Status  string               `json:"Status"`
+	Healthy bool                 `json:"Healthy"`
+	Checks  []ClusterHealthCheck `json:"Checks"`
+	Alerts  []ClusterAlert       `json:"Alerts"`
+}
+
+// dataSourceClusterHealth defines a data source exposing the health checks and active alerts of a cluster
+func dataSourceClusterHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the bugx cluster",
+			},
+			"on_unhealthy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warn",
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "warn", "fail"}, false),
+				Description:  "What to do when the cluster is unhealthy or has critical alerts: 'ignore', 'warn' or 'fail' (default: warn)",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Overall status of the cluster",
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every health check passes",
+			},
+			"checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Individual health check results",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the check (e.g., 'apiserver', 'etcd', 'coredns')",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Result of the check: 'Passing', 'Warning' or 'Failing'",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Details reported by the check",
+						},
+						"last_checked": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the check last ran",
+						},
+					},
+				},
+			},
+			"alerts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Alerts currently firing for the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the alert",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Severity of the alert: 'info', 'warning' or 'critical'",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Alert message",
+						},
+						"since": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the alert started firing",
+						},
+					},
+				},
+			},
+			"failing_checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the checks that are failing",
+			},
+		},
+	}
+}
+
+// dataSourceClusterHealthRead queries /cluster_health
+func dataSourceClusterHealthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	name := d.Get("name").(string)
+	health, err := fetchClusterHealth(ctx, client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if health == nil {
+		return diag.Errorf("cluster '%s' not found", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/cluster_health/%s", client.BaseURL, name))
+
+	checks := make([]map[string]interface{}, 0, len(health.Checks))
+	failing := make([]string, 0)
+	for _, c := range health.Checks {
+		checks = append(checks, map[string]interface{}{
+			"name":         c.Name,
+			"status":       c.Status,
+			"message":      c.Message,
+			"last_checked": c.LastChecked,
+		})
+		if c.Status == "Failing" {
+			failing = append(failing, c.Name)
+		}
+	}
+	alerts := make([]map[string]interface{}, 0, len(health.Alerts))
+	critical := make([]string, 0)
+	for _, a := range health.Alerts {
+		alerts = append(alerts, map[string]interface{}{
+			"name":     a.Name,
+			"severity": a.Severity,
+			"message":  a.Message,
+			"since":    a.Since,
+		})
+		if a.Severity == "critical" {
+			critical = append(critical, a.Name)
+		}
+	}
+
+	if err := d.Set("status", health.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("healthy", health.Healthy); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("checks", checks); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("alerts", alerts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("failing_checks", failing); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if health.Healthy && len(critical) == 0 {
+		return nil
+	}
+
+	severity := diag.Warning
+	switch d.Get("on_unhealthy").(string) {
+	case "ignore":
+		return nil
+	case "fail":
+		severity = diag.Error
+	}
+
+	var problems []string
+	if len(failing) > 0 {
+		problems = append(problems, fmt.Sprintf("failing checks: %s", strings.Join(failing, ", ")))
+	}
+	if len(critical) > 0 {
+		problems = append(problems, fmt.Sprintf("critical alerts: %s", strings.Join(critical, ", ")))
+	}
+	if len(problems) == 0 {
+		problems = append(problems, fmt.Sprintf("status %s", health.Status))
+	}
+	return diag.Diagnostics{
+		{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Cluster %s is degraded", name),
+			Detail:   strings.Join(problems, "; "),
+		},
+	}
+}
+
+// fetchClusterHealth queries /cluster_health?Clustername=<name>, returning nil if the cluster does not exist.
+func fetchClusterHealth(ctx context.Context, client *apiClient, name string) (*ClusterHealthResponse, error) {
+	u := fmt.Sprintf("%s/cluster_health?Clustername=%s", client.BaseURL, url.QueryEscape(name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("cluster health query failed: %s: %s", resp.Status, string(b))
+	}
+
+	var health ClusterHealthResponse
+	if err := decodeAPIResponse(resp, &health); err != nil {
+		return nil, fmt.Errorf("failed to decode cluster health response: %w", err)
+	}
+	return &health, nil
+}
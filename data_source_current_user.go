@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceCurrentUser defines a data source describing the credentials the provider logged in with.
+// It exports the same attributes as bugx_whoami and can additionally require a set of roles.
+func dataSourceCurrentUser() *schema.Resource {
+	r := dataSourceWhoami()
+	r.ReadContext = dataSourceCurrentUserRead
+	r.Schema["required_roles"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Fail unless the principal holds every one of these roles",
+	}
+	return r
+}
+
+// dataSourceCurrentUserRead queries /whoami and checks required_roles
+func dataSourceCurrentUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := dataSourceWhoamiRead(ctx, d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	held := make(map[string]bool)
+	for _, r := range d.Get("roles").([]interface{}) {
+		held[r.(string)] = true
+	}
+	var missing []string
+	for _, r := range d.Get("required_roles").(*schema.Set).List() {
+		if !held[r.(string)] {
+			missing = append(missing, r.(string))
+		}
+	}
+	if len(missing) > 0 {
+		return append(diags, diag.Errorf("%s is missing required roles: %s", d.Get("username").(string), strings.Join(missing, ", "))...)
+	}
+
+	return diags
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// HelmChartVersionInfo represents a chart version entry returned from /helm_chart_versions.
+type HelmChartVersionInfo struct {
+	Version    string `json:"Version"`
+	AppVersion string `json:"AppVersion"`
+	Created    string `json:"Created"`
+	Deprecated bool   `json:"Deprecated"`
+}
+
+// dataSourceHelmChartVersions defines a data source listing the published versions of a Helm chart
+func dataSourceHelmChartVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHelmChartVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repo": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "URL of the Helm repository hosting the chart",
+			},
+			"chart": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the chart in the repository (e.g., 'mysql')",
+			},
+			"version_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateVersionConstraint,
+				Description:  "Only return versions matching this constraint (e.g., '~> 9.4' or '>= 15.0, < 16.0')",
+			},
+			"include_prereleases": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include pre-release versions such as '2.0.0-rc.1' in versions and latest (default: false)",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching chart versions, newest first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version":     {Type: schema.TypeString, Computed: true, Description: "Chart version"},
+						"app_version": {Type: schema.TypeString, Computed: true, Description: "Application version packaged by the chart version"},
+						"created":     {Type: schema.TypeString, Computed: true, Description: "Time the chart version was published"},
+						"deprecated":  {Type: schema.TypeBool, Computed: true, Description: "Whether the chart version is marked deprecated"},
+					},
+				},
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Newest matching version",
+			},
+			"latest_stable": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Newest matching version that is neither a pre-release nor deprecated",
+			},
+		},
+	}
+}
+
+// dataSourceHelmChartVersionsRead queries /helm_chart_versions
+func dataSourceHelmChartVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	repo := d.Get("repo").(string)
+	chart := d.Get("chart").(string)
+	includePrereleases := d.Get("include_prereleases").(bool)
+
+	var constraints goversion.Constraints
+	if c := d.Get("version_constraint").(string); c != "" {
+		parsed, err := goversion.NewConstraint(c)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		constraints = parsed
+	}
+
+	all, err := fetchHelmChartVersions(ctx, client, repo, chart)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	type chartVersion struct {
+		parsed *goversion.Version
+		info   HelmChartVersionInfo
+	}
+	matching := make([]chartVersion, 0, len(all))
+	for _, info := range all {
+		v, err := goversion.NewVersion(info.Version)
+		if err != nil {
+			// Not semver; it cannot be ordered or matched against a constraint.
+			continue
+		}
+		if v.Prerelease() != "" && !includePrereleases {
+			continue
+		}
+		if constraints != nil && !constraints.Check(v) {
+			continue
+		}
+		matching = append(matching, chartVersion{parsed: v, info: info})
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].parsed.GreaterThan(matching[j].parsed)
+	})
+
+	versions := make([]map[string]interface{}, 0, len(matching))
+	latest, latestStable := "", ""
+	for _, cv := range matching {
+		versions = append(versions, map[string]interface{}{
+			"version":     cv.info.Version,
+			"app_version": cv.info.AppVersion,
+			"created":     cv.info.Created,
+			"deprecated":  cv.info.Deprecated,
+		})
+		if latest == "" {
+			latest = cv.info.Version
+		}
+		if latestStable == "" && cv.parsed.Prerelease() == "" && !cv.info.Deprecated {
+			latestStable = cv.info.Version
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", repo, chart))
+
+	if err := d.Set("versions", versions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("latest", latest); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("latest_stable", latestStable); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// validateVersionConstraint checks that a string parses as a version constraint.
+func validateVersionConstraint(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("%q must be a string", k)}
+	}
+	if _, err := goversion.NewConstraint(s); err != nil {
+		return nil, []error{fmt.Errorf("%q is not a valid version constraint: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// fetchHelmChartVersions queries /helm_chart_versions and returns the published versions of a chart.
+func fetchHelmChartVersions(ctx context.Context, client *apiClient, repo, chart string) ([]HelmChartVersionInfo, error) {
+	u := fmt.Sprintf("%s/helm_chart_versions?Repo=%s&Chart=%s", client.BaseURL, url.QueryEscape(repo), url.QueryEscape(chart))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("chart %s not found in repository %s", chart, repo)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("helm chart versions query failed: %s: %s", resp.Status, string(b))
+	}
+
+	var versions []HelmChartVersionInfo
+	if err := decodeAPIResponse(resp, &versions); err != nil {
+		return nil, fmt.Errorf("failed to decode helm chart versions response: %w", err)
+	}
+	return versions, nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceHelmReleases defines a data source listing the Helm releases installed in a cluster
+func dataSourceHelmReleases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHelmReleasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the bugx cluster to list releases from",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return releases in this Kubernetes namespace",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return releases with this status (e.g., 'deployed', 'failed')",
+			},
+			"chart": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return releases of this chart (e.g., 'bitnami/mysql' or 'mysql')",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only return releases whose name matches this regular expression",
+			},
+			"releases": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Releases matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"release":       {Type: schema.TypeString, Computed: true, Description: "Release name"},
+						"namespace":     {Type: schema.TypeString, Computed: true, Description: "Kubernetes namespace of the release"},
+						"chart":         {Type: schema.TypeString, Computed: true, Description: "Chart the release was installed from"},
+						"chart_version": {Type: schema.TypeString, Computed: true, Description: "Version of the deployed chart"},
+						"app_version":   {Type: schema.TypeString, Computed: true, Description: "Application version of the deployed chart"},
+						"status":        {Type: schema.TypeString, Computed: true, Description: "Release status reported by Helm"},
+						"revision":      {Type: schema.TypeInt, Computed: true, Description: "Revision number of the deployed release"},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the releases matching the filters",
+			},
+		},
+	}
+}
+
+// dataSourceHelmReleasesRead queries /helm_releases and applies the filters
+func dataSourceHelmReleasesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	clustername := d.Get("cluster_name").(string)
+	namespace := d.Get("namespace").(string)
+	status := d.Get("status").(string)
+	chart := d.Get("chart").(string)
+
+	var nameRegex *regexp.Regexp
+	if expr := d.Get("name_regex").(string); expr != "" {
+		nameRegex = regexp.MustCompile(expr)
+	}
+
+	all, err := fetchHelmReleases(ctx, client, clustername)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	releases := make([]map[string]interface{}, 0, len(all))
+	names := make([]string, 0, len(all))
+	for _, r := range all {
+		if namespace != "" && r.Namespace != namespace {
+			continue
+		}
+		if status != "" && r.Status != status {
+			continue
+		}
+		if chart != "" && r.Chart != chart && !strings.HasSuffix(r.Chart, "/"+chart) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(r.Release) {
+			continue
+		}
+		releases = append(releases, map[string]interface{}{
+			"release":       r.Release,
+			"namespace":     r.Namespace,
+			"chart":         r.Chart,
+			"chart_version": r.ChartVersion,
+			"app_version":   r.AppVersion,
+			"status":        r.Status,
+			"revision":      r.Revision,
+		})
+		names = append(names, r.Release)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", clustername, namespace))
+
+	if err := d.Set("releases", releases); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
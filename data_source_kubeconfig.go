@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfigCredentials holds the connection details of a kubeconfig's current context.
+type kubeconfigCredentials struct {
+	Host                 string
+	ClusterCACertificate string
+	ClientCertificate    string
+	ClientKey            string
+	Token                string
+}
+
+// dataSourceKubeconfig defines a data source fetching the kubeconfig of a cluster from /connect
+func dataSourceKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the bugx cluster",
+			},
+			"require_healthy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Fail when the cluster is not Healthy. If false, the attributes are left empty instead (default: true)",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the cluster",
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Raw kubeconfig content",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API server URL of the kubeconfig's current context",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded CA certificate of the API server",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded client certificate, if the kubeconfig uses one",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client key, if the kubeconfig uses one",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token, if the kubeconfig uses one",
+			},
+		},
+	}
+}
+
+// dataSourceKubeconfigRead queries /clusters for the status and /connect for the kubeconfig
+func dataSourceKubeconfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	name := d.Get("name").(string)
+	info, err := fetchClusterInfo(ctx, client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if info == nil {
+		return diag.Errorf("cluster '%s' not found", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/connect/%s", client.BaseURL, name))
+	if err := d.Set("status", info.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		kubeconfig string
+		creds      kubeconfigCredentials
+	)
+	if info.Status != "Healthy" {
+		if d.Get("require_healthy").(bool) {
+			return diag.Errorf("cluster '%s' is %s, not Healthy; set require_healthy = false to read it anyway", name, info.Status)
+		}
+	} else {
+		kubeconfig, err = fetchKubeconfig(ctx, client, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		creds, err = parseKubeconfigCredentials(kubeconfig)
+		if err != nil {
+			return diag.Errorf("failed to parse kubeconfig for cluster %s: %v", name, err)
+		}
+	}
+
+	if err := d.Set("kubeconfig", kubeconfig); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("host", creds.Host); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cluster_ca_certificate", creds.ClusterCACertificate); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("client_certificate", creds.ClientCertificate); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("client_key", creds.ClientKey); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("token", creds.Token); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// parseKubeconfigCredentials extracts the server, CA and user credentials of the current
+// context, falling back to the first cluster and user when no context is selected.
+func parseKubeconfigCredentials(content string) (kubeconfigCredentials, error) {
+	var creds kubeconfigCredentials
+	var doc struct {
+		CurrentContext string `yaml:"current-context"`
+		Contexts       []struct {
+			Name    string `yaml:"name"`
+			Context struct {
+				Cluster string `yaml:"cluster"`
+				User    string `yaml:"user"`
+			} `yaml:"context"`
+		} `yaml:"contexts"`
+		Clusters []struct {
+			Name    string `yaml:"name"`
+			Cluster struct {
+				Server                   string `yaml:"server"`
+				CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			} `yaml:"cluster"`
+		} `yaml:"clusters"`
+		Users []struct {
+			Name string `yaml:"name"`
+			User struct {
+				ClientCertificateData string `yaml:"client-certificate-data"`
+				ClientKeyData         string `yaml:"client-key-data"`
+				Token                 string `yaml:"token"`
+			} `yaml:"user"`
+		} `yaml:"users"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return creds, err
+	}
+	if len(doc.Clusters) == 0 {
+		return creds, fmt.Errorf("kubeconfig defines no clusters")
+	}
+
+	clusterName, userName := doc.Clusters[0].Name, ""
+	if len(doc.Users) > 0 {
+		userName = doc.Users[0].Name
+	}
+	for _, c := range doc.Contexts {
+		if c.Name == doc.CurrentContext {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+			break
+		}
+	}
+
+	for _, c := range doc.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		creds.Host = c.Cluster.Server
+		ca, err := decodeBase64PEM(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return creds, fmt.Errorf("invalid certificate-authority-data: %w", err)
+		}
+		creds.ClusterCACertificate = ca
+	}
+	for _, u := range doc.Users {
+		if u.Name != userName {
+			continue
+		}
+		cert, err := decodeBase64PEM(u.User.ClientCertificateData)
+		if err != nil {
+			return creds, fmt.Errorf("invalid client-certificate-data: %w", err)
+		}
+		key, err := decodeBase64PEM(u.User.ClientKeyData)
+		if err != nil {
+			return creds, fmt.Errorf("invalid client-key-data: %w", err)
+		}
+		creds.ClientCertificate, creds.ClientKey, creds.Token = cert, key, u.User.Token
+	}
+	return creds, nil
+}
+
+// decodeBase64PEM decodes the base64 *-data fields of a kubeconfig.
+func decodeBase64PEM(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
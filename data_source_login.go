@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceLogin defines a data source exposing the provider's session token
+func dataSourceLogin() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoginRead,
+
+		Schema: map[string]*schema.Schema{
+			"base_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base URL of the bugx API the token is valid for",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Session token obtained by the provider at login",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Token expiry as an RFC3339 timestamp, empty if the API does not report one",
+			},
+		},
+	}
+}
+
+// dataSourceLoginRead returns the session the provider is already authenticated with
+func dataSourceLoginRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	d.SetId(client.BaseURL)
+
+	if err := d.Set("base_url", client.BaseURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("token", client.Token); err != nil {
+		return diag.FromErr(err)
+	}
+
+	expiresAt := ""
+	if !client.TokenExpiry.IsZero() {
+		expiresAt = client.TokenExpiry.Format(time.RFC3339)
+	}
+	if err := d.Set("expires_at", expiresAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ComponentMetrics represents the usage of one control plane component returned from /cluster_metrics.
+type ComponentMetrics struct {
+	Name        string  `json:"Name"`        // e.g. "apiserver", "coredns"
+	CpuUsage    float64 `json:"CpuUsage"`    // cores
+	MemoryUsage int64   `json:"MemoryUsage"` // bytes
+}
+
+// ClusterMetricsResponse represents the JSON structure returned from /cluster_metrics.
+type ClusterMetricsResponse struct {
+	CpuUsage    float64            `json:"CpuUsage"`    // cores, whole control plane
+	MemoryUsage int64              `json:"MemoryUsage"` // bytes, whole control plane
+	Components  []ComponentMetrics `json:"Components"`
+	Timestamp   string             `json:"Timestamp"`
+}
+
+// bytesPerMiB converts the byte counts of /cluster_metrics to the MiB used by bugx_cluster's memory.
+const bytesPerMiB = 1024 * 1024
+
+// dataSourceMetrics defines a data source returning the control plane resource usage of a cluster
+func dataSourceMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the bugx cluster",
+			},
+			"window": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "5m",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: suppressEquivalentDuration,
+				Description:      "Period the usage is averaged over, as a Go duration (default: 5m)",
+			},
+			"cpu_usage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "CPU used by the whole control plane, in cores",
+			},
+			"memory_usage_mib": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Memory used by the whole control plane, in MiB",
+			},
+			"components": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Usage per control plane component",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":             {Type: schema.TypeString, Computed: true, Description: "Name of the component (e.g., 'apiserver', 'coredns')"},
+						"cpu_usage":        {Type: schema.TypeFloat, Computed: true, Description: "CPU used by the component, in cores"},
+						"memory_usage_mib": {Type: schema.TypeFloat, Computed: true, Description: "Memory used by the component, in MiB"},
+					},
+				},
+			},
+			"timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the usage was sampled",
+			},
+		},
+	}
+}
+
+// dataSourceMetricsRead queries /cluster_metrics
+func dataSourceMetricsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	name := d.Get("cluster_name").(string)
+	window, err := time.ParseDuration(d.Get("window").(string))
+	if err != nil {
+		return diag.Errorf("invalid window: %v", err)
+	}
+
+	metrics, err := fetchClusterMetrics(ctx, client, name, window)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if metrics == nil {
+		return diag.Errorf("cluster '%s' not found", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/cluster_metrics/%s", client.BaseURL, name))
+
+	components := make([]map[string]interface{}, 0, len(metrics.Components))
+	for _, c := range metrics.Components {
+		components = append(components, map[string]interface{}{
+			"name":             c.Name,
+			"cpu_usage":        c.CpuUsage,
+			"memory_usage_mib": float64(c.MemoryUsage) / bytesPerMiB,
+		})
+	}
+
+	if err := d.Set("cpu_usage", metrics.CpuUsage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("memory_usage_mib", float64(metrics.MemoryUsage)/bytesPerMiB); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("components", components); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("timestamp", metrics.Timestamp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// fetchClusterMetrics queries /cluster_metrics?Clustername=<name>&Window=<seconds>, returning nil if the cluster does not exist.
+func fetchClusterMetrics(ctx context.Context, client *apiClient, name string, window time.Duration) (*ClusterMetricsResponse, error) {
+	u := fmt.Sprintf("%s/cluster_metrics?Clustername=%s&Window=%d", client.BaseURL, url.QueryEscape(name), int(window.Seconds()))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("cluster metrics query failed: %s: %s", resp.Status, string(b))
+	}
+
+	var metrics ClusterMetricsResponse
+	if err := decodeAPIResponse(resp, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to decode cluster metrics response: %w", err)
+	}
+	return &metrics, nil
+}
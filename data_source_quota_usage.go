@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// quotaResource is one limit of a quota, named by its key in the API's used map.
+type quotaResource struct {
+	Name string
+	Hard string
+}
+
+// quotaResources lists the limits of a quota that are set. Unlimited resources are omitted.
+func quotaResources(h QuotaLimits) []quotaResource {
+	var out []quotaResource
+	for _, q := range []quotaResource{{"cpu", h.CPU}, {"memory", h.Memory}, {"storage", h.Storage}} {
+		if q.Hard != "" {
+			out = append(out, q)
+		}
+	}
+	for _, c := range []struct {
+		name  string
+		count int
+	}{
+		{"pods", h.Pods},
+		{"services", h.Services},
+		{"persistentVolumeClaims", h.PersistentVolumeClaims},
+		{"configMaps", h.ConfigMaps},
+		{"secrets", h.Secrets},
+	} {
+		if c.count > 0 {
+			out = append(out, quotaResource{Name: c.name, Hard: strconv.Itoa(c.count)})
+		}
+	}
+	return out
+}
+
+// dataSourceQuotaUsage defines a data source reporting the consumption of quotas per cluster and namespace
+func dataSourceQuotaUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceQuotaUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return quotas of this bugx cluster. If empty, quotas of every cluster are returned",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return quotas of this namespace",
+			},
+			"quotas": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Quotas matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":           {Type: schema.TypeString, Computed: true, Description: "ID of the quota"},
+						"cluster_name": {Type: schema.TypeString, Computed: true, Description: "Cluster the quota applies to"},
+						"namespace":    {Type: schema.TypeString, Computed: true, Description: "Namespace the quota applies to. Empty for a cluster-wide quota"},
+						"max_utilization": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Highest utilization of any resource of the quota, in percent",
+						},
+						"resources": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Usage of each limited resource",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name":        {Type: schema.TypeString, Computed: true, Description: "Resource name (e.g., 'cpu', 'memory', 'pods')"},
+									"hard":        {Type: schema.TypeString, Computed: true, Description: "Limit set by the quota"},
+									"used":        {Type: schema.TypeString, Computed: true, Description: "Current usage"},
+									"utilization": {Type: schema.TypeFloat, Computed: true, Description: "Usage as a percentage of the limit"},
+								},
+							},
+						},
+					},
+				},
+			},
+			"max_utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Highest utilization of any resource of the quotas matching the filters, in percent",
+			},
+		},
+	}
+}
+
+// dataSourceQuotaUsageRead queries /quota/api/v1/quotas and computes the utilization of each limit
+func dataSourceQuotaUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	clustername := d.Get("cluster_name").(string)
+	namespace := d.Get("namespace").(string)
+
+	q := url.Values{}
+	if clustername != "" {
+		q.Set("clusterName", clustername)
+	}
+	if namespace != "" {
+		q.Set("namespace", namespace)
+	}
+	u := fmt.Sprintf("%s/quota/api/v1/quotas", client.BaseURL)
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	var all []QuotaInfo
+	if err := fetchList(ctx, client, u, "quotas", &all); err != nil {
+		return diag.FromErr(err)
+	}
+
+	quotas := make([]map[string]interface{}, 0, len(all))
+	maxUtilization := 0.0
+	for _, quota := range all {
+		// The API filters already; check again in case it ignores the parameters.
+		if clustername != "" && quota.ClusterName != clustername {
+			continue
+		}
+		if namespace != "" && quota.Namespace != namespace {
+			continue
+		}
+
+		quotaMax := 0.0
+		resources := make([]map[string]interface{}, 0)
+		for _, r := range quotaResources(quota.Hard) {
+			used := quota.Used[r.Name]
+			utilization := 0.0
+			hard, okHard := parseQuantity(r.Hard)
+			usedValue, okUsed := parseQuantity(used)
+			if okHard && okUsed && hard > 0 {
+				utilization = usedValue / hard * 100
+			}
+			if utilization > quotaMax {
+				quotaMax = utilization
+			}
+			resources = append(resources, map[string]interface{}{
+				"name":        r.Name,
+				"hard":        r.Hard,
+				"used":        used,
+				"utilization": utilization,
+			})
+		}
+		if quotaMax > maxUtilization {
+			maxUtilization = quotaMax
+		}
+
+		quotas = append(quotas, map[string]interface{}{
+			"id":              quota.ID,
+			"cluster_name":    quota.ClusterName,
+			"namespace":       quota.Namespace,
+			"max_utilization": quotaMax,
+			"resources":       resources,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/quota/api/v1/quotas/%s:%s", client.BaseURL, clustername, namespace))
+
+	if err := d.Set("quotas", quotas); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("max_utilization", maxUtilization); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSecrets defines a data source listing secrets without their values
+func dataSourceSecrets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecretsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return secrets whose name starts with this prefix",
+			},
+			"secrets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Secrets matching the filter, sorted by name. Secret values are never included",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":          {Type: schema.TypeString, Computed: true, Description: "Secret ID"},
+						"name":        {Type: schema.TypeString, Computed: true, Description: "Secret name"},
+						"description": {Type: schema.TypeString, Computed: true, Description: "Secret description"},
+						"keys": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the data keys stored in the secret, sorted",
+						},
+						"created_at": {Type: schema.TypeString, Computed: true, Description: "Timestamp when the secret was created"},
+						"updated_at": {Type: schema.TypeString, Computed: true, Description: "Timestamp when the secret was last updated"},
+						"managed_by": {Type: schema.TypeString, Computed: true, Description: "Managed-by stamp carried by the secret"},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the secrets matching the filter, sorted",
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of secret name to ID, e.g. for for_each",
+			},
+		},
+	}
+}
+
+// dataSourceSecretsRead queries GET /secrets/api/v1/secrets
+func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	prefix := d.Get("name_prefix").(string)
+
+	all, err := fetchSecrets(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	secrets := make([]map[string]interface{}, 0, len(all))
+	names := make([]string, 0, len(all))
+	ids := make(map[string]string, len(all))
+	for _, s := range all {
+		if !strings.HasPrefix(s.Name, prefix) {
+			continue
+		}
+		keys := make([]string, 0, len(s.Data))
+		for k := range s.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		secrets = append(secrets, map[string]interface{}{
+			"id":          s.ID,
+			"name":        s.Name,
+			"description": s.Description,
+			"keys":        keys,
+			"created_at":  s.CreatedAt,
+			"updated_at":  s.UpdatedAt,
+			"managed_by":  s.Metadata[managedByKey],
+		})
+		names = append(names, s.Name)
+		ids[s.Name] = s.ID
+	}
+
+	d.SetId(fmt.Sprintf("%s/secrets/api/v1/secrets?prefix=%s", client.BaseURL, prefix))
+
+	if err := d.Set("secrets", secrets); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// HelmRepoInfo represents a Helm repository entry returned from /helm_repos.
+type HelmRepoInfo struct {
+	Name    string `json:"Name"`
+	Url     string `json:"Url"`
+	Allowed bool   `json:"Allowed"`
+}
+
+// HelmReposResponse represents the JSON structure returned from /helm_repos.
+type HelmReposResponse struct {
+	AllowListEnforced bool           `json:"AllowListEnforced"`
+	Repos             []HelmRepoInfo `json:"Repos"`
+}
+
+// dataSourceSupportedHelmRepos defines a data source listing the Helm repositories known to the platform
+func dataSourceSupportedHelmRepos() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSupportedHelmReposRead,
+
+		Schema: map[string]*schema.Schema{
+			"allow_list_enforced": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the platform rejects installs from repositories that are not allowed",
+			},
+			"repos": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Helm repositories registered on the platform",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Repository name (e.g., 'bitnami')",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Repository URL",
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether releases may be installed from this repository",
+						},
+					},
+				},
+			},
+			"allowed_urls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "URLs of the repositories releases may be installed from",
+			},
+		},
+	}
+}
+
+// dataSourceSupportedHelmReposRead queries /helm_repos
+func dataSourceSupportedHelmReposRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	repos, err := fetchHelmRepos(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/helm_repos", client.BaseURL))
+
+	if err := d.Set("allow_list_enforced", repos.AllowListEnforced); err != nil {
+		return diag.FromErr(err)
+	}
+
+	list := make([]map[string]interface{}, 0, len(repos.Repos))
+	allowedURLs := make([]string, 0, len(repos.Repos))
+	for _, r := range repos.Repos {
+		list = append(list, map[string]interface{}{
+			"name":    r.Name,
+			"url":     r.Url,
+			"allowed": r.Allowed,
+		})
+		if r.Allowed {
+			allowedURLs = append(allowedURLs, r.Url)
+		}
+	}
+	if err := d.Set("repos", list); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("allowed_urls", allowedURLs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// fetchHelmRepos queries /helm_repos and returns the registered repositories.
+func fetchHelmRepos(ctx context.Context, client *apiClient) (*HelmReposResponse, error) {
+	u := fmt.Sprintf("%s/helm_repos", client.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("helm repos query failed: %s: %s", resp.Status, string(b))
+	}
+
+	var repos HelmReposResponse
+	if err := decodeAPIResponse(resp, &repos); err != nil {
+		return nil, fmt.Errorf("failed to decode helm repos response: %w", err)
+	}
+	return &repos, nil
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceUsers defines a data source listing platform users and teams
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "member", "viewer"}, false),
+				Description:  "Only return users with this platform role: 'admin', 'member' or 'viewer'",
+			},
+			"team": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users that are members of this team",
+			},
+			"username_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only return users whose username matches this regular expression",
+			},
+			"include_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also return disabled accounts (default: false)",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":           {Type: schema.TypeString, Computed: true, Description: "ID of the user"},
+						"username":     {Type: schema.TypeString, Computed: true, Description: "Login name of the user"},
+						"email":        {Type: schema.TypeString, Computed: true, Description: "Email address of the user"},
+						"display_name": {Type: schema.TypeString, Computed: true, Description: "Display name of the user"},
+						"role":         {Type: schema.TypeString, Computed: true, Description: "Platform role of the user"},
+						"status":       {Type: schema.TypeString, Computed: true, Description: "Account status (e.g., 'invited', 'active', 'disabled')"},
+						"teams": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the teams the user is a member of",
+						},
+					},
+				},
+			},
+			"usernames": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Usernames of the users matching the filters",
+			},
+			"teams": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All teams on the platform",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":          {Type: schema.TypeString, Computed: true, Description: "ID of the team"},
+						"name":        {Type: schema.TypeString, Computed: true, Description: "Name of the team"},
+						"description": {Type: schema.TypeString, Computed: true, Description: "Description of the team"},
+						"members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Members of the team",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"username": {Type: schema.TypeString, Computed: true, Description: "Username of the member"},
+									"role":     {Type: schema.TypeString, Computed: true, Description: "Role of the member within the team"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceUsersRead queries /users/api/v1/users and /teams/api/v1/teams and applies the filters
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	role := d.Get("role").(string)
+	team := d.Get("team").(string)
+	includeDisabled := d.Get("include_disabled").(bool)
+
+	var usernameRegex *regexp.Regexp
+	if expr := d.Get("username_regex").(string); expr != "" {
+		usernameRegex = regexp.MustCompile(expr)
+	}
+
+	allUsers, err := fetchUsers(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allTeams, err := fetchTeams(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teamFound := team == ""
+	membership := make(map[string][]string)
+	teams := make([]map[string]interface{}, 0, len(allTeams))
+	for _, t := range allTeams {
+		if t.Name == team {
+			teamFound = true
+		}
+		members := make([]map[string]interface{}, 0, len(t.Members))
+		for _, mem := range t.Members {
+			members = append(members, map[string]interface{}{
+				"username": mem.Username,
+				"role":     mem.Role,
+			})
+			membership[mem.Username] = append(membership[mem.Username], t.Name)
+		}
+		teams = append(teams, map[string]interface{}{
+			"id":          t.ID,
+			"name":        t.Name,
+			"description": t.Description,
+			"members":     members,
+		})
+	}
+	if !teamFound {
+		return diag.Errorf("team '%s' not found", team)
+	}
+
+	users := make([]map[string]interface{}, 0, len(allUsers))
+	usernames := make([]string, 0, len(allUsers))
+	for _, u := range allUsers {
+		if !includeDisabled && u.Status == "disabled" {
+			continue
+		}
+		if role != "" && u.Role != role {
+			continue
+		}
+		userTeams := membership[u.Username]
+		sort.Strings(userTeams)
+		if team != "" {
+			if i := sort.SearchStrings(userTeams, team); i == len(userTeams) || userTeams[i] != team {
+				continue
+			}
+		}
+		if usernameRegex != nil && !usernameRegex.MatchString(u.Username) {
+			continue
+		}
+		users = append(users, map[string]interface{}{
+			"id":           u.ID,
+			"username":     u.Username,
+			"email":        u.Email,
+			"display_name": u.DisplayName,
+			"role":         u.Role,
+			"status":       u.Status,
+			"teams":        userTeams,
+		})
+		usernames = append(usernames, u.Username)
+	}
+
+	d.SetId(fmt.Sprintf("%s/users/api/v1/users", client.BaseURL))
+
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("usernames", usernames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("teams", teams); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// fetchUsers queries GET /users/api/v1/users and returns every platform user.
+func fetchUsers(ctx context.Context, client *apiClient) ([]UserInfo, error) {
+	var users []UserInfo
+	if err := fetchList(ctx, client, fmt.Sprintf("%s/users/api/v1/users", client.BaseURL), "users", &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// fetchTeams queries GET /teams/api/v1/teams and returns every team.
+func fetchTeams(ctx context.Context, client *apiClient) ([]TeamInfo, error) {
+	var teams []TeamInfo
+	if err := fetchList(ctx, client, fmt.Sprintf("%s/teams/api/v1/teams", client.BaseURL), "teams", &teams); err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// fetchList issues an authenticated GET to u and decodes the JSON array response into out.
+func fetchList(ctx context.Context, client *apiClient, u, what string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s list failed: %s: %s", what, resp.Status, string(b))
+	}
+
+	if err := decodeAPIResponse(resp, out); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", what, err)
+	}
+	return nil
+}
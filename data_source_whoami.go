@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// whoamiResponse represents the JSON structure returned from /whoami.
+type whoamiResponse struct {
+	Username         string   `json:"username"`
+	UserID           string   `json:"userId"`
+	Team             string   `json:"team,omitempty"`
+	Roles            []string `json:"roles"`
+	IsServiceAccount bool     `json:"isServiceAccount"`
+	ExpiresAt        string   `json:"expiresAt,omitempty"`
+}
+
+// dataSourceWhoami defines a data source describing the principal the provider is authenticated as
+func dataSourceWhoami() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWhoamiRead,
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Username of the authenticated principal",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the authenticated principal",
+			},
+			"team": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Team the principal belongs to, if any",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Roles granted to the principal",
+			},
+			"is_service_account": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the principal is a service account rather than a human user",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Token expiry as an RFC3339 timestamp, empty if unknown",
+			},
+		},
+	}
+}
+
+// dataSourceWhoamiRead queries /whoami for the current session
+func dataSourceWhoamiRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	who, err := fetchWhoami(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := who.UserID
+	if id == "" {
+		id = who.Username
+	}
+	d.SetId(id)
+
+	if err := d.Set("username", who.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_id", who.UserID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("team", who.Team); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("roles", who.Roles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_service_account", who.IsServiceAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Prefer the expiry the provider derived at login; it covers JWTs without an API-reported expiry.
+	expiresAt := who.ExpiresAt
+	if !client.TokenExpiry.IsZero() {
+		expiresAt = client.TokenExpiry.Format(time.RFC3339)
+	}
+	if err := d.Set("expires_at", expiresAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// fetchWhoami queries /whoami and returns the authenticated principal.
+func fetchWhoami(ctx context.Context, client *apiClient) (*whoamiResponse, error) {
+	u := fmt.Sprintf("%s/whoami", client.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("whoami query failed: %s: %s", resp.Status, string(b))
+	}
+
+	var who whoamiResponse
+	if err := decodeAPIResponse(resp, &who); err != nil {
+		return nil, fmt.Errorf("failed to decode whoami response: %w", err)
+	}
+	return &who, nil
+}
@@ -0,0 +1,499 @@
+// Command mockapi is an in-memory stand-in for the bugx API, used by the provider's
+// end-to-end tests. It implements the endpoints behind bugx_cluster, bugx_app and
+// bugx_secret closely enough for create, read, update and delete to round-trip, and
+// nothing else. State is lost when it exits.
+//
+// Environment:
+//
+//	MOCK_ADDR           listen address (default ":8080")
+//	MOCK_USERNAME       accepted username (default "e2e")
+//	MOCK_PASSWORD       accepted password (default "e2e")
+//	MOCK_READY_AFTER    how long clusters and apps report a pending status (default "1s")
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mockToken is the token handed out by /login and required on every other request.
+const mockToken = "mock-token"
+
+// cluster mirrors the fields of the provider's ClusterPayload and ClusterInfo.
+type cluster struct {
+	Name                string            `json:"Name"`
+	ClusterID           string            `json:"ClusterID"`
+	Status              string            `json:"Status"`
+	Version             string            `json:"Version"`
+	HealthCheck         string            `json:"HealthCheck"`
+	Alert               string            `json:"Alert"`
+	EndPoint            string            `json:"EndPoint"`
+	NameSpace           string            `json:"NameSpace"`
+	IsolationMode       string            `json:"IsolationMode,omitempty"`
+	PodSecurityStandard string            `json:"PodSecurityStandard,omitempty"`
+	CreationTimestamp   string            `json:"CreationTimestamp,omitempty"`
+	CreatedBy           string            `json:"CreatedBy,omitempty"`
+	LastModified        string            `json:"LastModified,omitempty"`
+	Labels              map[string]string `json:"Labels,omitempty"`
+
+	PlatformVersion string `json:"PlatformVersion,omitempty"`
+
+	readyAt time.Time
+}
+
+// app mirrors the provider's CatalogAppPayload and CatalogAppInfo.
+type app struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ClusterName string `json:"clusterName"`
+	App         string `json:"app"`
+	Version     string `json:"version"`
+	Namespace   string `json:"namespace"`
+	Values      string `json:"values"`
+	Status      string `json:"status"`
+	Message     string `json:"message"`
+
+	readyAt time.Time
+}
+
+// secret mirrors the provider's SecretPayload and SecretInfo.
+type secret struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Data        map[string]string `json:"data"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	SyncTo      []json.RawMessage `json:"syncTo,omitempty"`
+	CreatedAt   string            `json:"createdAt,omitempty"`
+	UpdatedAt   string            `json:"updatedAt,omitempty"`
+	Version     int               `json:"version,omitempty"`
+	Rotate      bool              `json:"rotate,omitempty"`
+}
+
+// server holds the mock's in-memory state.
+type server struct {
+	username, password string
+	readyAfter         time.Duration
+
+	mu       sync.Mutex
+	nextID   int
+	clusters map[string]*cluster // by name
+	apps     map[string]*app     // by ID
+	secrets  map[string]*secret  // by ID
+}
+
+func main() {
+	readyAfter, err := time.ParseDuration(envOr("MOCK_READY_AFTER", "1s"))
+	if err != nil {
+		log.Fatalf("invalid MOCK_READY_AFTER: %v", err)
+	}
+	s := &server{
+		username:   envOr("MOCK_USERNAME", "e2e"),
+		password:   envOr("MOCK_PASSWORD", "e2e"),
+		readyAfter: readyAfter,
+		clusters:   map[string]*cluster{},
+		apps:       map[string]*app{},
+		secrets:    map[string]*secret{},
+	}
+
+	addr := envOr("MOCK_ADDR", ":8080")
+	log.Printf("mock bugx API listening on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, logRequests(s.routes())))
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	mux.HandleFunc("POST /login", s.login)
+
+	mux.HandleFunc("POST /createcluster", s.auth(s.createCluster))
+	mux.HandleFunc("GET /clusters", s.auth(s.listClusters))
+	mux.HandleFunc("GET /connect", s.auth(s.connect))
+	mux.HandleFunc("DELETE /deletecluster", s.auth(s.deleteCluster))
+
+	mux.HandleFunc("POST /catalog/api/v1/installations", s.auth(s.createApp))
+	mux.HandleFunc("GET /catalog/api/v1/installations/{id}", s.auth(s.getApp))
+	mux.HandleFunc("PUT /catalog/api/v1/installations/{id}", s.auth(s.updateApp))
+	mux.HandleFunc("DELETE /catalog/api/v1/installations/{id}", s.auth(s.deleteApp))
+
+	mux.HandleFunc("POST /secrets/api/v1/secrets", s.auth(s.createSecret))
+	mux.HandleFunc("GET /secrets/api/v1/secrets", s.auth(s.listSecrets))
+	mux.HandleFunc("GET /secrets/api/v1/secrets/{id}", s.auth(s.getSecret))
+	mux.HandleFunc("PUT /secrets/api/v1/secrets/{id}", s.auth(s.updateSecret))
+	mux.HandleFunc("PATCH /secrets/api/v1/secrets/{id}", s.auth(s.updateSecret))
+	mux.HandleFunc("DELETE /secrets/api/v1/secrets/{id}", s.auth(s.deleteSecret))
+	return mux
+}
+
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("%s %s", r.Method, r.URL.RequestURI())
+		next.ServeHTTP(w, r)
+	})
+}
+
+// auth rejects requests without the token from /login, with or without "Bearer ".
+func (s *server) auth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ") != mockToken {
+			writeError(w, http.StatusUnauthorized, "missing or invalid token")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+func now() string { return time.Now().UTC().Format(time.RFC3339) }
+
+// newID returns a unique ID with prefix. The caller must hold s.mu.
+func (s *server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%d", prefix, s.nextID)
+}
+
+func (s *server) login(w http.ResponseWriter, r *http.Request) {
+	var creds struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&creds); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if creds.Username != s.username || creds.Password != s.password {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{
+		"token":     mockToken,
+		"expiresAt": time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339),
+	})
+}
+
+// refresh moves clusters and apps past their pending status. The caller must hold s.mu.
+func (s *server) refresh() {
+	t := time.Now()
+	for _, c := range s.clusters {
+		if c.Status == "Progressing" && !t.Before(c.readyAt) {
+			c.Status = "Healthy"
+			c.HealthCheck = "ok"
+			c.LastModified = now()
+		}
+	}
+	for _, a := range s.apps {
+		if a.Status == "installing" && !t.Before(a.readyAt) {
+			a.Status = "running"
+			a.Message = ""
+		}
+	}
+}
+
+func (s *server) createCluster(w http.ResponseWriter, r *http.Request) {
+	var c cluster
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if c.Name == "" {
+		writeError(w, http.StatusBadRequest, "Name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.clusters[c.Name]; exists {
+		writeError(w, http.StatusConflict, fmt.Sprintf("cluster %s already exists", c.Name))
+		return
+	}
+	if c.ClusterID == "" {
+		c.ClusterID = s.newID("cluster")
+	}
+	if c.IsolationMode == "" {
+		c.IsolationMode = "standard"
+	}
+	if c.PodSecurityStandard == "" {
+		c.PodSecurityStandard = "baseline"
+	}
+	c.Status = "Progressing"
+	c.Version = c.PlatformVersion
+	c.NameSpace = "vc-" + c.Name
+	c.EndPoint = fmt.Sprintf("https://%s.mock.bugx.local", c.Name)
+	c.CreationTimestamp = now()
+	c.LastModified = c.CreationTimestamp
+	c.CreatedBy = s.username
+	c.readyAt = time.Now().Add(s.readyAfter)
+	s.clusters[c.Name] = &c
+	writeJSON(w, http.StatusCreated, map[string]string{"message": "cluster creation started"})
+}
+
+func (s *server) listClusters(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("Name")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refresh()
+	list := []cluster{}
+	for _, c := range s.clusters {
+		if name == "" || c.Name == name {
+			list = append(list, *c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (s *server) connect(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("Name")
+
+	s.mu.Lock()
+	s.refresh()
+	c, ok := s.clusters[name]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "cluster not found")
+		return
+	}
+	if c.Status != "Healthy" {
+		writeError(w, http.StatusServiceUnavailable, "cluster is not ready")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	fmt.Fprintf(w, `apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: %[2]s
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s
+current-context: %[1]s
+users:
+- name: %[1]s
+  user:
+    token: %[3]s
+`, c.Name, c.EndPoint, mockToken)
+}
+
+func (s *server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("Name")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[name]; !ok {
+		writeError(w, http.StatusNotFound, "cluster not found")
+		return
+	}
+	delete(s.clusters, name)
+	writeJSON(w, http.StatusOK, map[string]string{"message": "cluster deleted"})
+}
+
+func (s *server) createApp(w http.ResponseWriter, r *http.Request) {
+	var a app
+	if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[a.ClusterName]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", a.ClusterName))
+		return
+	}
+	a.ID = s.newID("app")
+	if a.Version == "" {
+		a.Version = "1.0.0"
+	}
+	if a.Namespace == "" {
+		a.Namespace = a.App
+	}
+	a.Status = "installing"
+	a.readyAt = time.Now().Add(s.readyAfter)
+	s.apps[a.ID] = &a
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *server) getApp(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refresh()
+	a, ok := s.apps[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "app not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *server) updateApp(w http.ResponseWriter, r *http.Request) {
+	var in app
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.apps[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "app not found")
+		return
+	}
+	if in.Version != "" {
+		a.Version = in.Version
+	}
+	a.Values = in.Values
+	a.Status = "installing"
+	a.readyAt = time.Now().Add(s.readyAfter)
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *server) deleteApp(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := r.PathValue("id")
+	if _, ok := s.apps[id]; !ok {
+		writeError(w, http.StatusNotFound, "app not found")
+		return
+	}
+	delete(s.apps, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// etag returns the ETag of a secret revision, which the provider sends back in If-Match.
+func (sec *secret) etag() string { return strconv.Quote(strconv.Itoa(sec.Version)) }
+
+func (s *server) createSecret(w http.ResponseWriter, r *http.Request) {
+	var sec secret
+	if err := json.NewDecoder(r.Body).Decode(&sec); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.secrets {
+		if existing.Name == sec.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("secret %s already exists", sec.Name))
+			return
+		}
+	}
+	sec.ID = s.newID("secret")
+	sec.Version = 1
+	sec.Rotate = false
+	sec.CreatedAt = now()
+	sec.UpdatedAt = sec.CreatedAt
+	s.secrets[sec.ID] = &sec
+	w.Header().Set("ETag", sec.etag())
+	writeJSON(w, http.StatusCreated, sec)
+}
+
+func (s *server) listSecrets(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := []secret{}
+	for _, sec := range s.secrets {
+		list = append(list, *sec)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"secrets": list})
+}
+
+func (s *server) getSecret(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sec, ok := s.secrets[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "secret not found")
+		return
+	}
+	w.Header().Set("ETag", sec.etag())
+	writeJSON(w, http.StatusOK, sec)
+}
+
+func (s *server) updateSecret(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		Name        string             `json:"name"`
+		Description string             `json:"description"`
+		Data        map[string]*string `json:"data"`
+		Metadata    map[string]string  `json:"metadata"`
+		SyncTo      []json.RawMessage  `json:"syncTo"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sec, ok := s.secrets[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "secret not found")
+		return
+	}
+	if match := r.Header.Get("If-Match"); match != "" && match != sec.etag() {
+		writeError(w, http.StatusPreconditionFailed, "secret was modified")
+		return
+	}
+
+	// PUT replaces the data; PATCH merges it and deletes keys set to null.
+	if r.Method == http.MethodPut {
+		sec.Data = map[string]string{}
+	}
+	for k, v := range in.Data {
+		if v == nil {
+			delete(sec.Data, k)
+			continue
+		}
+		sec.Data[k] = *v
+	}
+	sec.Name = in.Name
+	sec.Description = in.Description
+	sec.Metadata = in.Metadata
+	sec.SyncTo = in.SyncTo
+	sec.Version++
+	sec.UpdatedAt = now()
+	w.Header().Set("ETag", sec.etag())
+	writeJSON(w, http.StatusOK, sec)
+}
+
+func (s *server) deleteSecret(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := r.PathValue("id")
+	if _, ok := s.secrets[id]; !ok {
+		writeError(w, http.StatusNotFound, "secret not found")
+		return
+	}
+	delete(s.secrets, id)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,442 @@
+//go:build e2e
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// End-to-end tests run the bugx_cluster, bugx_app and bugx_secret resources through
+// create, read, update and destroy against the mock API in e2e/mockapi. Run them with
+// `make testacc-e2e`, which builds the mock image and starts it in Docker.
+//
+// Environment:
+//
+//	BUGX_MOCK_IMAGE    mock API image to start (default "bugx-mockapi:dev")
+//	BUGX_E2E_BASE_URL  use an already running API instead of starting a container
+const (
+	e2eUsername = "e2e"
+	e2ePassword = "e2e"
+)
+
+// e2eBaseURL is the base URL of the API the tests run against, set by TestMain.
+var e2eBaseURL string
+
+func TestMain(m *testing.M) {
+	baseURL, stop, err := startMockAPI()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start the mock API: %v\n", err)
+		os.Exit(1)
+	}
+	e2eBaseURL = baseURL
+
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+// startMockAPI starts the mock API container and waits until it answers. It returns the
+// API's base URL and a function that removes the container.
+func startMockAPI() (string, func(), error) {
+	if baseURL := os.Getenv("BUGX_E2E_BASE_URL"); baseURL != "" {
+		return baseURL, func() {}, waitForMockAPI(baseURL)
+	}
+
+	image := os.Getenv("BUGX_MOCK_IMAGE")
+	if image == "" {
+		image = "bugx-mockapi:dev"
+	}
+	out, err := exec.Command("docker", "run", "-d", "--rm", "-p", "127.0.0.1::8080", image).Output()
+	if err != nil {
+		return "", nil, fmt.Errorf("docker run %s: %w", image, commandError(err))
+	}
+	id := strings.TrimSpace(string(out))
+	stop := func() {
+		_ = exec.Command("docker", "rm", "-f", id).Run()
+	}
+
+	out, err = exec.Command("docker", "port", id, "8080/tcp").Output()
+	if err != nil {
+		stop()
+		return "", nil, fmt.Errorf("docker port: %w", commandError(err))
+	}
+	// docker port prints one line per address family; the first is enough.
+	addr := strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+	baseURL := "http://" + addr
+
+	if err := waitForMockAPI(baseURL); err != nil {
+		logs, _ := exec.Command("docker", "logs", id).CombinedOutput()
+		stop()
+		return "", nil, fmt.Errorf("%w\ncontainer logs:\n%s", err, logs)
+	}
+	return baseURL, stop, nil
+}
+
+// commandError adds the stderr of a failed command to err.
+func commandError(err error) error {
+	if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
+
+// waitForMockAPI polls the mock's health endpoint until it responds.
+func waitForMockAPI(baseURL string) error {
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		resp, err := http.Get(baseURL + "/healthz")
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode < 300 {
+				return nil
+			}
+			err = fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("mock API at %s did not become ready: %v", baseURL, err)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// e2eProvider returns the provider configured against the mock API, and its client.
+func e2eProvider(t *testing.T) (*schema.Provider, interface{}) {
+	t.Helper()
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"base_url": e2eBaseURL,
+		"username": e2eUsername,
+		"password": e2ePassword,
+	}))
+	requireNoErrors(t, "configure provider", diags)
+	return p, p.Meta()
+}
+
+func requireNoErrors(t *testing.T, step string, diags diag.Diagnostics) {
+	t.Helper()
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			t.Fatalf("%s: %s: %s", step, d.Summary, d.Detail)
+		}
+	}
+}
+
+// e2eResource drives one resource type through the same calls Terraform makes for plan,
+// apply and refresh.
+type e2eResource struct {
+	t    *testing.T
+	name string
+	r    *schema.Resource
+	meta interface{}
+}
+
+func newE2EResource(t *testing.T, p *schema.Provider, meta interface{}, name string) *e2eResource {
+	r, ok := p.ResourcesMap[name]
+	if !ok {
+		t.Fatalf("provider has no resource %s", name)
+	}
+	return &e2eResource{t: t, name: name, r: r, meta: meta}
+}
+
+// plan validates config and diffs it against state.
+func (e *e2eResource) plan(state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceDiff {
+	e.t.Helper()
+	cfg := terraform.NewResourceConfigRaw(config)
+	requireNoErrors(e.t, "validate "+e.name, e.r.Validate(cfg))
+	diff, err := e.r.Diff(context.Background(), state, cfg, e.meta)
+	if err != nil {
+		e.t.Fatalf("plan %s: %v", e.name, err)
+	}
+	if diff != nil {
+		// Terraform hands the raw configuration to apply along with the diff.
+		diff.RawConfig = e.rawConfig(config)
+	}
+	return diff
+}
+
+// rawConfig converts config to the cty value Terraform sends as the raw configuration.
+func (e *e2eResource) rawConfig(config map[string]interface{}) cty.Value {
+	e.t.Helper()
+	b, err := json.Marshal(config)
+	if err != nil {
+		e.t.Fatalf("encode %s config: %v", e.name, err)
+	}
+	v, err := ctyjson.Unmarshal(b, e.r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		e.t.Fatalf("convert %s config: %v", e.name, err)
+	}
+	return v
+}
+
+// apply plans config against state and applies the result, as terraform apply does.
+func (e *e2eResource) apply(state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	e.t.Helper()
+	diff := e.plan(state, config)
+	if !hasChanges(diff) {
+		return state
+	}
+	if state != nil && diff.RequiresNew() {
+		e.t.Fatalf("apply %s: update unexpectedly requires replacement:\n%s", e.name, formatDiff(diff))
+	}
+	newState, diags := e.r.Apply(context.Background(), state, diff, e.meta)
+	requireNoErrors(e.t, "apply "+e.name, diags)
+	if newState == nil || newState.ID == "" {
+		e.t.Fatalf("apply %s: resource has no ID", e.name)
+	}
+	return newState
+}
+
+// refresh reads the resource, returning nil if it no longer exists.
+func (e *e2eResource) refresh(state *terraform.InstanceState) *terraform.InstanceState {
+	e.t.Helper()
+	newState, diags := e.r.RefreshWithoutUpgrade(context.Background(), state, e.meta)
+	requireNoErrors(e.t, "refresh "+e.name, diags)
+	if newState == nil || newState.ID == "" {
+		return nil
+	}
+	return newState
+}
+
+// expectNoChanges fails if config still plans changes after it was applied.
+func (e *e2eResource) expectNoChanges(state *terraform.InstanceState, config map[string]interface{}) {
+	e.t.Helper()
+	if diff := e.plan(state, config); hasChanges(diff) {
+		e.t.Errorf("%s: plan after apply is not empty:\n%s", e.name, formatDiff(diff))
+	}
+}
+
+// hasChanges reports whether diff changes any attribute. The diff of a resource with an
+// identity is never nil, since it carries the identity along.
+func hasChanges(diff *terraform.InstanceDiff) bool {
+	return diff != nil && (len(diff.Attributes) > 0 || diff.Destroy)
+}
+
+// formatDiff renders the attribute changes of diff, one per line.
+func formatDiff(diff *terraform.InstanceDiff) string {
+	var b strings.Builder
+	for k, a := range diff.Attributes {
+		fmt.Fprintf(&b, "  %s: %q => %q", k, a.Old, a.New)
+		if a.NewComputed {
+			b.WriteString(" (known after apply)")
+		}
+		if a.RequiresNew {
+			b.WriteString(" (forces replacement)")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// destroy deletes the resource and checks that a refresh no longer finds it.
+func (e *e2eResource) destroy(state *terraform.InstanceState) {
+	e.t.Helper()
+	newState, diags := e.r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, e.meta)
+	requireNoErrors(e.t, "destroy "+e.name, diags)
+	if newState != nil && newState.ID != "" {
+		e.t.Fatalf("destroy %s: resource still has ID %s", e.name, newState.ID)
+	}
+	if gone := e.refresh(state); gone != nil {
+		e.t.Fatalf("destroy %s: resource %s still exists", e.name, state.ID)
+	}
+}
+
+// checkAttrs compares state attributes with want. A want value ending in "*" is matched
+// as a prefix, and "?" only requires the attribute to be non-empty.
+func checkAttrs(t *testing.T, state *terraform.InstanceState, want map[string]string) {
+	t.Helper()
+	if state == nil {
+		t.Fatalf("resource does not exist")
+	}
+	for k, w := range want {
+		got := state.Attributes[k]
+		switch {
+		case w == "?":
+			if got == "" {
+				t.Errorf("%s is empty", k)
+			}
+		case strings.HasSuffix(w, "*"):
+			if !strings.HasPrefix(got, strings.TrimSuffix(w, "*")) {
+				t.Errorf("%s = %q, want prefix %q", k, got, strings.TrimSuffix(w, "*"))
+			}
+		case got != w:
+			t.Errorf("%s = %q, want %q", k, got, w)
+		}
+	}
+}
+
+func e2eClusterConfig(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":             name,
+		"control_plane":    "k8s",
+		"cpu":              "2",
+		"memory":           "4Gi",
+		"platform_version": "1.30",
+		"cluster_type":     "shared",
+		"coredns_cpu":      "100m",
+		"coredns_memory":   "128Mi",
+		"apiserver_cpu":    "500m",
+		"apiserver_memory": "512Mi",
+	}
+}
+
+// createE2ECluster creates a cluster for resources that need one and removes it when
+// the test ends.
+func createE2ECluster(t *testing.T, p *schema.Provider, meta interface{}, name string) {
+	t.Helper()
+	clusters := newE2EResource(t, p, meta, "bugx_cluster")
+	state := clusters.apply(nil, e2eClusterConfig(name))
+	t.Cleanup(func() { clusters.destroy(state) })
+}
+
+func TestE2ECluster(t *testing.T) {
+	p, meta := e2eProvider(t)
+	clusters := newE2EResource(t, p, meta, "bugx_cluster")
+
+	config := e2eClusterConfig("e2e-cluster")
+	state := clusters.apply(nil, config)
+	checkAttrs(t, state, map[string]string{
+		"name":       "e2e-cluster",
+		"cluster_id": "?",
+		"status":     "Healthy",
+		"namespace":  "vc-e2e-cluster",
+		"kubeconfig": "apiVersion: v1*",
+	})
+	if state.ID != state.Attributes["cluster_id"] {
+		t.Errorf("ID = %q, want the cluster_id %q", state.ID, state.Attributes["cluster_id"])
+	}
+
+	state = clusters.refresh(state)
+	checkAttrs(t, state, map[string]string{"status": "Healthy", "endpoint": "https://e2e-cluster.*"})
+	clusters.expectNoChanges(state, config)
+
+	config["auto_recreate_on_failed"] = true
+	updated := clusters.apply(state, config)
+	if updated.ID != state.ID {
+		t.Errorf("update changed the ID from %s to %s", state.ID, updated.ID)
+	}
+	checkAttrs(t, updated, map[string]string{"auto_recreate_on_failed": "true", "status": "Healthy"})
+	clusters.expectNoChanges(updated, config)
+
+	clusters.destroy(updated)
+}
+
+func TestE2EApp(t *testing.T) {
+	p, meta := e2eProvider(t)
+	createE2ECluster(t, p, meta, "e2e-app-cluster")
+	apps := newE2EResource(t, p, meta, "bugx_app")
+
+	config := map[string]interface{}{
+		"name":         "grafana",
+		"cluster_name": "e2e-app-cluster",
+		"app":          "grafana",
+		"values":       "replicas: 1\n",
+	}
+	state := apps.apply(nil, config)
+	checkAttrs(t, state, map[string]string{
+		"status":    "running",
+		"version":   "1.0.0",
+		"namespace": "grafana",
+		"values":    "replicas: 1\n",
+	})
+
+	state = apps.refresh(state)
+	apps.expectNoChanges(state, config)
+
+	config["values"] = "replicas: 2\n"
+	updated := apps.apply(state, config)
+	if updated.ID != state.ID {
+		t.Errorf("update changed the ID from %s to %s", state.ID, updated.ID)
+	}
+	checkAttrs(t, updated, map[string]string{"status": "running", "values": "replicas: 2\n"})
+	apps.expectNoChanges(updated, config)
+
+	apps.destroy(updated)
+}
+
+func TestE2ESecret(t *testing.T) {
+	tests := []struct {
+		name       string
+		hashValues bool
+		// wantValue is the expected state value of data.password after an apply.
+		wantValue func(plaintext string) string
+	}{
+		{
+			name:      "plaintext",
+			wantValue: func(plaintext string) string { return plaintext },
+		},
+		{
+			name:       "hash_values",
+			hashValues: true,
+			wantValue:  func(string) string { return hashedValuePrefix + "*" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, meta := e2eProvider(t)
+			secrets := newE2EResource(t, p, meta, "bugx_secret")
+
+			config := map[string]interface{}{
+				"name":        "e2e-" + strings.ReplaceAll(tt.name, "_", "-"),
+				"description": "created by the e2e tests",
+				"hash_values": tt.hashValues,
+				"data": map[string]interface{}{
+					"username": "admin",
+					"password": "first",
+				},
+			}
+			state := secrets.apply(nil, config)
+			checkAttrs(t, state, map[string]string{
+				"name":             config["name"].(string),
+				"data.password":    tt.wantValue("first"),
+				"version":          "1",
+				"resource_version": `"1"`,
+			})
+
+			state = secrets.refresh(state)
+			secrets.expectNoChanges(state, config)
+
+			config["data"] = map[string]interface{}{
+				"username": "admin",
+				"password": "second",
+			}
+			updated := secrets.apply(state, config)
+			if updated.ID != state.ID {
+				t.Errorf("update changed the ID from %s to %s", state.ID, updated.ID)
+			}
+			checkAttrs(t, updated, map[string]string{
+				"data.password":    tt.wantValue("second"),
+				"version":          "2",
+				"resource_version": `"2"`,
+			})
+			if tt.hashValues && !hashedValueMatches(updated.Attributes["data.password"], "second") {
+				t.Errorf("data.password = %q does not match the new value", updated.Attributes["data.password"])
+			}
+			// Unchanged keys must reach the API as plaintext, not as their value in state.
+			stored, err := fetchSecretByID(context.Background(), meta.(*apiClient), updated.ID)
+			if err != nil || stored == nil {
+				t.Fatalf("fetch secret %s: %v", updated.ID, err)
+			}
+			if stored.Data["username"] != "admin" || stored.Data["password"] != "second" {
+				t.Errorf("API holds data %v, want username=admin and password=second", stored.Data)
+			}
+			secrets.expectNoChanges(updated, config)
+
+			secrets.destroy(updated)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tokenEphemeralTypeName is the type name of the bugx_token ephemeral resource.
+const tokenEphemeralTypeName = "bugx_token"
+
+// tokenEphemeralType is the object type of the bugx_token ephemeral resource.
+var tokenEphemeralType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"username":   tftypes.String,
+		"password":   tftypes.String,
+		"scopes":     tftypes.List{ElementType: tftypes.String},
+		"ttl":        tftypes.String,
+		"token":      tftypes.String,
+		"expires_at": tftypes.String,
+	},
+}
+
+// providerServer serves the SDK provider and adds the ephemeral resources the SDK
+// cannot express. Every other RPC is handled by the embedded SDK server.
+type providerServer struct {
+	*schema.GRPCProviderServer
+	provider *schema.Provider
+}
+
+// newProviderServer wraps the SDK's gRPC server for p.
+func newProviderServer(p *schema.Provider) tfprotov5.ProviderServer {
+	return &providerServer{
+		GRPCProviderServer: schema.NewGRPCProviderServer(p),
+		provider:           p,
+	}
+}
+
+// GetMetadata adds the ephemeral resources to the SDK's metadata.
+func (s *providerServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.GRPCProviderServer.GetMetadata(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	resp.EphemeralResources = append(resp.EphemeralResources, tfprotov5.EphemeralResourceMetadata{TypeName: tokenEphemeralTypeName})
+	return resp, nil
+}
+
+// GetProviderSchema adds the ephemeral resource schemas to the SDK's schema.
+func (s *providerServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.GRPCProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	resp.EphemeralResourceSchemas[tokenEphemeralTypeName] = tokenEphemeralSchema()
+	return resp, nil
+}
+
+// tokenEphemeralSchema defines the bugx_token ephemeral resource schema.
+func tokenEphemeralSchema() *tfprotov5.Schema {
+	return &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Description: "Exchanges credentials for a bugx API token at apply time without storing it in state",
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:        "username",
+					Type:        tftypes.String,
+					Optional:    true,
+					Description: "Username to log in with. Defaults to the provider's username",
+				},
+				{
+					Name:        "password",
+					Type:        tftypes.String,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password to log in with. Defaults to the provider's password",
+				},
+				{
+					Name:        "scopes",
+					Type:        tftypes.List{ElementType: tftypes.String},
+					Optional:    true,
+					Description: "Restrict the token to these scopes. If empty, the token has the user's full permissions",
+				},
+				{
+					Name:        "ttl",
+					Type:        tftypes.String,
+					Optional:    true,
+					Description: "Requested token lifetime as a Go duration (e.g., '15m'). If empty, the platform default applies",
+				},
+				{
+					Name:        "token",
+					Type:        tftypes.String,
+					Computed:    true,
+					Sensitive:   true,
+					Description: "The issued API token",
+				},
+				{
+					Name:        "expires_at",
+					Type:        tftypes.String,
+					Computed:    true,
+					Description: "Time the token expires, in RFC 3339 format, if known",
+				},
+			},
+		},
+	}
+}
+
+// ValidateEphemeralResourceConfig checks the ttl of bugx_token.
+func (s *providerServer) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	if req.TypeName != tokenEphemeralTypeName {
+		return s.GRPCProviderServer.ValidateEphemeralResourceConfig(ctx, req)
+	}
+
+	attrs, err := decodeTokenConfig(req.Config)
+	if err != nil {
+		return &tfprotov5.ValidateEphemeralResourceConfigResponse{Diagnostics: protoDiagnostics(diag.FromErr(err))}, nil
+	}
+
+	if ttl := attrs["ttl"]; ttl.IsKnown() && !ttl.IsNull() {
+		var v string
+		if err := ttl.As(&v); err == nil {
+			if _, err := time.ParseDuration(v); err != nil {
+				return &tfprotov5.ValidateEphemeralResourceConfigResponse{
+					Diagnostics: protoDiagnostics(diag.Errorf("ttl %q is not a valid duration: %v", v, err)),
+				}, nil
+			}
+		}
+	}
+	return &tfprotov5.ValidateEphemeralResourceConfigResponse{}, nil
+}
+
+// OpenEphemeralResource logs in and returns a token for bugx_token.
+func (s *providerServer) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	if req.TypeName != tokenEphemeralTypeName {
+		return s.GRPCProviderServer.OpenEphemeralResource(ctx, req)
+	}
+
+	client, ok := s.provider.Meta().(*apiClient)
+	if !ok || client == nil {
+		return &tfprotov5.OpenEphemeralResourceResponse{Diagnostics: protoDiagnostics(diag.Errorf("invalid API client configuration"))}, nil
+	}
+
+	attrs, err := decodeTokenConfig(req.Config)
+	if err != nil {
+		return &tfprotov5.OpenEphemeralResourceResponse{Diagnostics: protoDiagnostics(diag.FromErr(err))}, nil
+	}
+
+	creds, err := tokenLoginRequest(attrs, client.credentials)
+	if err != nil {
+		return &tfprotov5.OpenEphemeralResourceResponse{Diagnostics: protoDiagnostics(diag.FromErr(err))}, nil
+	}
+
+	lr, diags := login(ctx, client.HTTPClient, client.BaseURL, creds)
+	if diags.HasError() {
+		return &tfprotov5.OpenEphemeralResourceResponse{Diagnostics: protoDiagnostics(diags)}, nil
+	}
+
+	attrs["token"] = tftypes.NewValue(tftypes.String, lr.Token)
+	attrs["expires_at"] = tftypes.NewValue(tftypes.String, nil)
+	if expiry := tokenExpiry(lr); !expiry.IsZero() {
+		attrs["expires_at"] = tftypes.NewValue(tftypes.String, expiry.Format(time.RFC3339))
+	}
+
+	result, err := tfprotov5.NewDynamicValue(tokenEphemeralType, tftypes.NewValue(tokenEphemeralType, attrs))
+	if err != nil {
+		return &tfprotov5.OpenEphemeralResourceResponse{Diagnostics: protoDiagnostics(diag.FromErr(err))}, nil
+	}
+	return &tfprotov5.OpenEphemeralResourceResponse{
+		Result:      &result,
+		Diagnostics: protoDiagnostics(diags),
+	}, nil
+}
+
+// RenewEphemeralResource is a no-op for bugx_token; tokens are not renewed.
+func (s *providerServer) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	if req.TypeName != tokenEphemeralTypeName {
+		return s.GRPCProviderServer.RenewEphemeralResource(ctx, req)
+	}
+	return &tfprotov5.RenewEphemeralResourceResponse{}, nil
+}
+
+// CloseEphemeralResource is a no-op for bugx_token; the token expires on its own.
+func (s *providerServer) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	if req.TypeName != tokenEphemeralTypeName {
+		return s.GRPCProviderServer.CloseEphemeralResource(ctx, req)
+	}
+	return &tfprotov5.CloseEphemeralResourceResponse{}, nil
+}
+
+// decodeTokenConfig decodes a bugx_token configuration into its attributes.
+func decodeTokenConfig(config *tfprotov5.DynamicValue) (map[string]tftypes.Value, error) {
+	if config == nil {
+		return nil, fmt.Errorf("missing bugx_token configuration")
+	}
+	val, err := config.Unmarshal(tokenEphemeralType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bugx_token configuration: %w", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := val.As(&attrs); err != nil {
+		return nil, fmt.Errorf("failed to decode bugx_token configuration: %w", err)
+	}
+	return attrs, nil
+}
+
+// tokenLoginRequest builds the /login request for bugx_token, falling back to the
+// provider's credentials for an unset username or password.
+func tokenLoginRequest(attrs map[string]tftypes.Value, defaults loginRequest) (loginRequest, error) {
+	creds := loginRequest{
+		Username: defaults.Username,
+		Password: defaults.Password,
+	}
+
+	for name, target := range map[string]*string{"username": &creds.Username, "password": &creds.Password, "ttl": &creds.TTL} {
+		var v *string
+		if err := attrs[name].As(&v); err != nil {
+			return creds, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		if v != nil && *v != "" {
+			*target = *v
+		}
+	}
+
+	if scopes := attrs["scopes"]; !scopes.IsNull() {
+		var elems []tftypes.Value
+		if err := scopes.As(&elems); err != nil {
+			return creds, fmt.Errorf("invalid scopes: %w", err)
+		}
+		for _, e := range elems {
+			var scope string
+			if err := e.As(&scope); err != nil {
+				return creds, fmt.Errorf("invalid scopes: %w", err)
+			}
+			creds.Scopes = append(creds.Scopes, scope)
+		}
+	}
+
+	return creds, nil
+}
+
+// protoDiagnostics converts SDK diagnostics to protocol diagnostics.
+func protoDiagnostics(diags diag.Diagnostics) []*tfprotov5.Diagnostic {
+	out := make([]*tfprotov5.Diagnostic, 0, len(diags))
+	for _, d := range diags {
+		severity := tfprotov5.DiagnosticSeverityError
+		if d.Severity == diag.Warning {
+			severity = tfprotov5.DiagnosticSeverityWarning
+		}
+		out = append(out, &tfprotov5.Diagnostic{
+			Severity: severity,
+			Summary:  d.Summary,
+			Detail:   d.Detail,
+		})
+	}
+	return out
+}
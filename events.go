@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// watchEvents subscribes to a server-sent events stream at path and signals wake
+// whenever an event arrives, so that a waitFor loop can check right away instead of
+// sleeping until its next poll. A dropped stream is reopened with the same backoff as
+// the polling in cfg, and the delay is reset once a stream delivers events; if the API
+// does not offer the stream at all, watchEvents returns and the caller keeps polling.
+// It runs until ctx is done or cfg.Timeout expires.
+func watchEvents(ctx context.Context, client *apiClient, path string, wake chan<- struct{}, cfg WaitConfig) {
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+	cfg = cfg.withDefaults()
+
+	// The stream stays open for the whole wait, so it must not inherit the client timeout.
+	stream := client.withoutTimeout().HTTPClient
+
+	delay := cfg.InitialInterval
+	for ctx.Err() == nil {
+		events, supported, err := readEventStream(ctx, stream, client, path, wake)
+		if !supported {
+			log.Printf("[DEBUG] %s is not available, relying on polling: %v", path, err)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if events > 0 {
+			delay = cfg.InitialInterval
+		}
+		log.Printf("[DEBUG] event stream %s closed, reconnecting in %s: %v", path, delay, err)
+		if sleepContext(ctx, delay) != nil {
+			return
+		}
+		delay = cfg.nextInterval(delay)
+	}
+}
+
+// readEventStream reads one connection to the event stream until it ends. It returns
+// the number of events received and whether the API supports the stream, i.e. whether
+// reconnecting is worthwhile.
+func readEventStream(ctx context.Context, httpClient *http.Client, client *apiClient, path string, wake chan<- struct{}) (int, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.BaseURL+path, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// Network errors are transient; the stream may well exist.
+		return 0, true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, false, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		return 0, false, fmt.Errorf("unexpected content type %q", resp.Header.Get("Content-Type"))
+	}
+
+	// Each event ends with a blank line; comments (lines starting with ':') are keep-alives.
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	pending := false
+	events := 0
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if pending {
+				select {
+				case wake <- struct{}{}:
+				default:
+				}
+				pending = false
+				events++
+			}
+		case strings.HasPrefix(line, "data:"):
+			pending = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return events, true, err
+	}
+	return events, true, fmt.Errorf("stream ended")
+}
@@ -9,7 +9,7 @@ resource "bugx_helm_release" "mysql" {
   chart       = "bitnami/mysql"
   
   # Option 1: Use a values file
-  values_file = "${path.module}/helm-values/mysql-values.yaml"
+  values      = [file("${path.module}/helm-values/mysql-values.yaml")]
   
   depends_on = [bugx_cluster.devcluster]
 }
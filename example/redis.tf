@@ -10,7 +10,7 @@
 #   repo        = "https://charts.bitnami.com/bitnami"
   
 #   # Option 1: Use a values file
-#   values_file = "${path.module}/helm-values/redis-values.yaml"
+#   values      = [file("${path.module}/helm-values/redis-values.yaml")]
 #   # Wait for cluster to be ready before deploying
 #   depends_on = [bugx_cluster.debugx]
 # }
@@ -3,11 +3,20 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,6 +30,9 @@ type RetryConfig struct {
 	BackoffMultiplier float64
 }
 
+// idempotencyKeyHeader lets the API recognize a retried request it already applied.
+const idempotencyKeyHeader = "Idempotency-Key"
+
 // DefaultRetryConfig returns sensible defaults for retry configuration
 func DefaultRetryConfig() RetryConfig {
 	return RetryConfig{
@@ -31,31 +43,89 @@ func DefaultRetryConfig() RetryConfig {
 	}
 }
 
-// isRetryableError checks if an error is retryable
+// isRetryableError reports whether a failed request may succeed when sent again:
+// timeouts, connections that were reset, refused or closed early, and DNS lookups
+// that failed temporarily. Cancellation by the caller and permanent failures, such
+// as TLS certificate errors or an unknown host, are not retried.
 func isRetryableError(err error) bool {
 	if err == nil {
 		return false
 	}
-	errStr := err.Error()
-	
-	// Retry on network errors, EOF, connection resets, and timeouts
-	retryableErrors := []string{
-		"EOF",
-		"connection reset",
-		"connection refused",
-		"timeout",
-		"temporary failure",
-		"no such host",
-		"network is unreachable",
+	// Client.Timeout errors wrap context.DeadlineExceeded and are worth retrying, so only
+	// cancellation is excluded here; an expired caller context is checked by the caller.
+	if errors.Is(err, context.Canceled) {
+		return false
 	}
-	
-	for _, retryable := range retryableErrors {
-		if strings.Contains(strings.ToLower(errStr), strings.ToLower(retryable)) {
-			return true
-		}
+
+	// A certificate that does not verify will not verify on the next attempt either.
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalidCert x509.CertificateInvalidError
+	var verifyErr *tls.CertificateVerificationError
+	if errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalidCert) || errors.As(err, &verifyErr) {
+		return false
 	}
-	
-	return false
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, syscall.ENETUNREACH) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isRetryableMethod reports whether a request may be sent again after a failure that
+// the API may already have acted on: safe and idempotent methods, and requests carrying
+// an idempotency key, which is how a single request opts in to retries.
+func isRetryableMethod(method string, header http.Header) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return header.Get(idempotencyKeyHeader) != ""
+}
+
+// isUnsentRequestError reports whether err shows that a request never reached the API,
+// so that even a non-idempotent request can safely be sent again.
+func isUnsentRequestError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// setIdempotencyKey adds a random idempotencyKeyHeader to a create request when the
+// provider's idempotency_keys option is enabled, so that the request is retried like
+// an idempotent one. Without it a failed create is not retried, since it may already
+// have created the object.
+func (c *apiClient) setIdempotencyKey(req *http.Request) error {
+	if !c.IdempotencyKeys {
+		return nil
+	}
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return err
+	}
+	req.Header.Set(idempotencyKeyHeader, key)
+	return nil
+}
+
+// newIdempotencyKey returns a random key for idempotencyKeyHeader.
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
 }
 
 // isRetryableStatusCode checks if an HTTP status code is retryable
@@ -64,15 +134,48 @@ func isRetryableStatusCode(statusCode int) bool {
 	return statusCode >= 500 && statusCode < 600 || statusCode == 429
 }
 
-// doRequestWithRetry performs an HTTP request with retry logic
+// doRequestWithRetry performs an HTTP request with retry logic. The request body is
+// read once and every attempt is sent with its own copy, so callers do not need to
+// set GetBody for retries.
 func doRequestWithRetry(ctx context.Context, client *apiClient, req *http.Request, retryConfig RetryConfig) (*http.Response, error) {
+	body, err := requestBodyBytes(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	return doRequestBytes(ctx, client, req.Method, req.URL.String(), req.Header, body, retryConfig)
+}
+
+// requestBodyBytes returns the full body of req, or nil if it has none.
+func requestBodyBytes(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+// doRequestBytes sends body to url, retrying according to retryConfig. A new request
+// is built from body for every attempt, so a retry is never sent with an already
+// consumed, empty body. header is copied onto every attempt.
+func doRequestBytes(ctx context.Context, client *apiClient, method, url string, header http.Header, body []byte, retryConfig RetryConfig) (*http.Response, error) {
 	var lastErr error
 	delay := retryConfig.InitialDelay
+	// A POST that failed after reaching the API may have been applied, e.g. created a
+	// cluster or installed a release, so it is only retried when that is known to be safe.
+	retryable := isRetryableMethod(method, header)
 	
 	for attempt := 0; attempt <= retryConfig.MaxRetries; attempt++ {
 		if attempt > 0 {
 			// Log retry attempt
-			log.Printf("[WARN] Retrying request to %s (attempt %d/%d) after %v", req.URL.String(), attempt, retryConfig.MaxRetries, delay)
+			log.Printf("[WARN] Retrying request to %s (attempt %d/%d) after %v", url, attempt, retryConfig.MaxRetries, delay)
 			
 			// Wait before retry
 			select {
@@ -88,35 +191,18 @@ func doRequestWithRetry(ctx context.Context, client *apiClient, req *http.Reques
 			}
 		}
 		
-		// Create a new request for each retry (request body can only be read once)
-		var newReq *http.Request
-		var err error
-		
-		// Try to get the body for retry
-		var body io.Reader
-		if req.GetBody != nil {
-			bodyReader, bodyErr := req.GetBody()
-			if bodyErr == nil {
-				body = bodyReader
-			}
-		} else if req.Body != nil {
-			// If GetBody is not available, read the body into a buffer
-			// This is a fallback for requests that don't support GetBody
-			bodyBytes, readErr := io.ReadAll(req.Body)
-			if readErr == nil {
-				body = bytes.NewReader(bodyBytes)
-				// Restore original body for potential future reads
-				req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-			}
+		// Build a new request for each attempt (a request body can only be read once)
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
 		}
-		
-		newReq, err = http.NewRequestWithContext(ctx, req.Method, req.URL.String(), body)
+		newReq, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create retry request: %w", err)
 		}
 		
 		// Copy headers
-		for k, v := range req.Header {
+		for k, v := range header {
 			newReq.Header[k] = v
 		}
 		
@@ -125,15 +211,23 @@ func doRequestWithRetry(ctx context.Context, client *apiClient, req *http.Reques
 		
 		// Check for retryable errors
 		if err != nil {
+			if ctx.Err() != nil {
+				// Cancelled or timed out by the caller; retrying cannot succeed.
+				return nil, ctx.Err()
+			}
 			lastErr = err
-			if isRetryableError(err) && attempt < retryConfig.MaxRetries {
+			if (isUnsentRequestError(err) || retryable && isRetryableError(err)) && attempt < retryConfig.MaxRetries {
 				continue
 			}
+			if !retryable && isRetryableError(err) {
+				log.Printf("[WARN] Not retrying %s %s: the request may already have been applied and has no idempotency key", method, url)
+			}
 			return nil, err
 		}
 		
 		// Check for retryable status codes
-		if isRetryableStatusCode(resp.StatusCode) && attempt < retryConfig.MaxRetries {
+		// 429 means the request was rejected before it was processed.
+		if (retryable && isRetryableStatusCode(resp.StatusCode) || resp.StatusCode == http.StatusTooManyRequests) && attempt < retryConfig.MaxRetries {
 			// Read and close the response body before retrying
 			io.Copy(io.Discard, resp.Body)
 			resp.Body.Close()
@@ -148,6 +242,16 @@ func doRequestWithRetry(ctx context.Context, client *apiClient, req *http.Reques
 	return nil, fmt.Errorf("max retries exceeded: %w", lastErr)
 }
 
+// withoutTimeout returns a copy of c whose HTTP client has no overall timeout, for
+// long-running requests that are bounded by their context instead.
+func (c *apiClient) withoutTimeout() *apiClient {
+	cc := *c
+	httpClient := *c.HTTPClient
+	httpClient.Timeout = 0
+	cc.HTTPClient = &httpClient
+	return &cc
+}
+
 // doRequestWithRetryDiag is a wrapper that returns diag.Diagnostics for Terraform
 func doRequestWithRetryDiag(ctx context.Context, client *apiClient, req *http.Request, retryConfig RetryConfig) (*http.Response, diag.Diagnostics) {
 	resp, err := doRequestWithRetry(ctx, client, req, retryConfig)
@@ -157,3 +261,93 @@ func doRequestWithRetryDiag(ctx context.Context, client *apiClient, req *http.Re
 	return resp, nil
 }
 
+// rateLimitState tracks the API quota reported by rate-limit response headers.
+type rateLimitState struct {
+	mu        sync.Mutex
+	known     bool
+	remaining int
+	limit     int
+	reset     time.Time
+	observed  int
+}
+
+// rateLimitSnapshot is a point-in-time copy of rateLimitState.
+type rateLimitSnapshot struct {
+	Known     bool
+	Remaining int
+	Limit     int
+	Reset     time.Time
+	Observed  int
+}
+
+// observe records the rate-limit headers of a response, if present.
+func (s *rateLimitState) observe(req *http.Request, h http.Header) {
+	remaining, ok := headerInt(h, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	if !ok {
+		return
+	}
+	limit, _ := headerInt(h, "X-RateLimit-Limit", "RateLimit-Limit")
+
+	var reset time.Time
+	if v, ok := headerInt(h, "X-RateLimit-Reset", "RateLimit-Reset"); ok {
+		// Large values are epoch seconds, small values are seconds until reset.
+		if v > 1000000000 {
+			reset = time.Unix(int64(v), 0).UTC()
+		} else {
+			reset = time.Now().Add(time.Duration(v) * time.Second).UTC()
+		}
+	}
+
+	s.mu.Lock()
+	s.known = true
+	s.remaining = remaining
+	s.limit = limit
+	s.reset = reset
+	s.observed++
+	s.mu.Unlock()
+
+	log.Printf("[DEBUG] API rate limit after %s %s: remaining=%d limit=%d reset=%s", req.Method, req.URL.Path, remaining, limit, reset.Format(time.RFC3339))
+	if limit > 0 && remaining*10 < limit {
+		log.Printf("[WARN] API rate limit nearly exhausted: %d of %d requests remaining until %s", remaining, limit, reset.Format(time.RFC3339))
+	}
+}
+
+// snapshot returns the latest observed quota.
+func (s *rateLimitState) snapshot() rateLimitSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return rateLimitSnapshot{
+		Known:     s.known,
+		Remaining: s.remaining,
+		Limit:     s.limit,
+		Reset:     s.reset,
+		Observed:  s.observed,
+	}
+}
+
+// headerInt returns the first of the given headers that holds an integer.
+func headerInt(h http.Header, names ...string) (int, bool) {
+	for _, name := range names {
+		if v := h.Get(name); v != "" {
+			if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// rateLimitTransport records rate-limit headers from every API response.
+type rateLimitTransport struct {
+	base  http.RoundTripper
+	state *rateLimitState
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		t.state.observe(req, resp.Header)
+	}
+	return resp, err
+}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: Provider,
+		// Wrap the SDK server to add ephemeral resources such as bugx_token.
+		GRPCProviderFunc: func() tfprotov5.ProviderServer {
+			return newProviderServer(Provider())
+		},
 	})
 }
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// managedByKey is the cluster label and secret metadata key holding the managed-by stamp.
+const managedByKey = "bugx.io/managed-by"
+
+// managedByVars is the data available to the provider's managed_by template.
+type managedByVars struct {
+	Workspace string
+	StatePath string
+}
+
+// renderManagedBy renders the managed_by template, e.g. "terraform:{{ .Workspace }}:{{ .StatePath }}".
+// Workspace comes from TF_WORKSPACE, as Terraform does not pass it to providers.
+func renderManagedBy(tmpl, statePath string) (string, error) {
+	vars := managedByVars{
+		Workspace: os.Getenv("TF_WORKSPACE"),
+		StatePath: statePath,
+	}
+	if vars.Workspace == "" {
+		vars.Workspace = "default"
+	}
+	if vars.StatePath == "" {
+		if wd, err := os.Getwd(); err == nil {
+			vars.StatePath = wd
+		}
+	}
+
+	t, err := template.New("managed_by").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse managed_by template: %w", err)
+	}
+	var out bytes.Buffer
+	if err := t.Execute(&out, vars); err != nil {
+		return "", fmt.Errorf("failed to render managed_by template: %w", err)
+	}
+	return out.String(), nil
+}
+
+// stampManagedBy returns a copy of labels with the managed-by stamp added. Labels are
+// returned unchanged when the provider has no managed_by template configured.
+func (c *apiClient) stampManagedBy(labels map[string]string) map[string]string {
+	if c.ManagedBy == "" {
+		return labels
+	}
+	stamped := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		stamped[k] = v
+	}
+	stamped[managedByKey] = c.ManagedBy
+	return stamped
+}
+
+// verifyManagedBy fails when require_managed_by is set and the object carries another
+// configuration's stamp. Unstamped objects, e.g. created before stamping was enabled,
+// are allowed so they can be adopted.
+func (c *apiClient) verifyManagedBy(kind, name, current string) diag.Diagnostics {
+	if !c.RequireManagedBy || c.ManagedBy == "" || current == "" || current == c.ManagedBy {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s %s is managed by another configuration", kind, name),
+			Detail:   fmt.Sprintf("The object is stamped %s=%q but this provider stamps %q. The provider is configured with require_managed_by = true, so it will not modify or delete it.", managedByKey, current, c.ManagedBy),
+		},
+	}
+}
@@ -3,14 +3,18 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // apiClient holds configuration and auth token for talking to the backend API.
@@ -19,17 +23,72 @@ type apiClient struct {
 	Token       string
 	HTTPClient  *http.Client
 	RetryConfig RetryConfig
+	TestMode    bool
+
+	// TokenExpiry is when Token expires; zero if unknown.
+	TokenExpiry time.Time
+
+	// RateLimit holds the latest API quota reported by response headers.
+	RateLimit *rateLimitState
+
+	// PreflightChecks enables plan-time API lookups in CustomizeDiff.
+	PreflightChecks bool
+
+	// Strict turns recoverable failures reported through softFailure into errors.
+	Strict bool
+
+	// ManagedBy is the rendered stamp written to clusters and secrets; empty disables stamping.
+	ManagedBy string
+	// RequireManagedBy refuses to update or delete objects stamped by another configuration.
+	RequireManagedBy bool
+
+	// SecretEncryption is the provider-level secret_encryption block; nil if unset.
+	SecretEncryption *secretEncryptionConfig
+
+	// IdempotencyKeys sends an Idempotency-Key with cluster creation, Helm requests and
+	// selected creates so that they can be retried without being applied twice.
+	IdempotencyKeys bool
+
+	// APICompatibility selects which list response shapes are accepted; see decodeAPIList.
+	APICompatibility string
+
+	// clusters caches the /clusters list for the current operation; nil disables caching.
+	clusters *clusterCache
+
+	// credentials are the provider's login credentials, the default for bugx_token.
+	credentials loginRequest
+}
+
+// softFailure reports a failure the provider can work around, such as a kubeconfig
+// that could not be fetched. It is logged as a warning and ignored, unless strict
+// mode is enabled, in which case it is returned as an error diagnostic.
+func (c *apiClient) softFailure(format string, args ...interface{}) diag.Diagnostics {
+	msg := fmt.Sprintf(format, args...)
+	if c.Strict {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  msg,
+				Detail:   "The provider is configured with strict = true, so this failure is reported as an error instead of being ignored.",
+			},
+		}
+	}
+	log.Printf("[WARN] %s", msg)
+	return nil
 }
 
 // loginRequest represents the request body for /login.
 type loginRequest struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	Scopes   []string `json:"scopes,omitempty"` // Optional: restrict the token to these scopes
+	TTL      string   `json:"ttl,omitempty"`    // Optional: requested token lifetime, e.g. "15m"
 }
 
 // loginResponse represents the response body from /login.
 type loginResponse struct {
-	Token string `json:"token"`
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expiresAt,omitempty"` // Optional: RFC3339 expiry, if the API reports one
 }
 
 // Provider defines the bugx Terraform provider.
@@ -47,6 +106,12 @@ func Provider() *schema.Provider {
 				Sensitive:   true,
 				Description: "Password for login to bugx API",
 			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BUGX_BASE_URL", "https://bugx.ir"),
+				Description: "Base URL of the bugx API, e.g. a local mock API for testing. Can also be set with BUGX_BASE_URL (default: https://bugx.ir)",
+			},
 			"timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -59,18 +124,147 @@ func Provider() *schema.Provider {
 				Default:     3,
 				Description: "Maximum number of retries for failed requests (default: 3)",
 			},
+			"test_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create lightweight mock clusters from the backend's test tier and skip long health waits. Intended for module CI only (default: false)",
+			},
+			"preflight_checks": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Query the API during plan to catch conflicts early, e.g. a cluster name that already exists outside of state (default: false)",
+			},
+			"strict": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail instead of logging a warning when a secondary step fails, e.g. a kubeconfig fetch, namespace lookup or response decode (default: false)",
+			},
+			"managed_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template for the managed-by stamp written to clusters and secrets, e.g. 'terraform:{{ .Workspace }}:{{ .StatePath }}'. Stamping is disabled when empty",
+			},
+			"state_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value of {{ .StatePath }} in managed_by, e.g. the backend key of the state. Defaults to the working directory",
+			},
+			"require_managed_by": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"managed_by"},
+				Description:  "Refuse to update or delete clusters and secrets stamped by a different managed_by value (default: false)",
+			},
+			"secret_encryption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Encrypt the data of every bugx_secret client-side, unless the secret has its own encryption block",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"secret_encryption.0.kms_key_id", "secret_encryption.0.public_key_pem"},
+							Description:  "ID of a platform KMS key whose RSA public key is used for encryption",
+						},
+						"public_key_pem": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validatePublicKeyPEM,
+							Description:  "PEM encoded RSA public key used for encryption",
+						},
+					},
+				},
+			},
+			"secret_change_detection_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Key for the MAC that encrypted and hashed secret values carry to detect changes. Defaults to a key derived from password, so rotating the password shows a diff on every encrypted or hashed secret; set it to keep plans stable across password changes",
+			},
+			"idempotency_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send an Idempotency-Key header with cluster creation, Helm install, upgrade and rollback requests and the creates of secrets, apps, cleanup policies, cluster groups, node pools, teams and users, and retry them like other requests. Only enable it if the API deduplicates requests by this key (default: false)",
+			},
+			"api_compatibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      apiCompatAuto,
+				ValidateFunc: validation.StringInSlice([]string{apiCompatAuto, apiCompatStrict}, false),
+				Description:  "How list responses are decoded: 'auto' accepts a JSON array, a single object or an {items: [...]} envelope as returned by different backend versions; 'strict' only accepts the documented shape (default: auto)",
+			},
+			"cluster_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds the cluster list is cached and shared between resources during an operation. Any write clears the cache; 0 disables it (default: 30)",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"bugx_cluster":        resourceCluster(),
-			"bugx_helm_release":   resourceHelmRelease(),
-			"bugx_orphan_cleanup": resourceOrphanCleanup(),
-			"bugx_secret":         resourceSecret(),
+			"bugx_api_token":             resourceAPIToken(),
+			"bugx_app":                   resourceCatalogApp(),
+			"bugx_backup_schedule":       resourceBackupSchedule(),
+			"bugx_cleanup_policy":        resourceCleanupPolicy(),
+			"bugx_cluster":               resourceCluster(),
+			"bugx_cluster_cleanup":       resourceClusterCleanup(),
+			"bugx_cluster_group":         resourceClusterGroup(),
+			"bugx_configmap":             resourceConfigMap(),
+			"bugx_connection_gateway":    resourceConnectionGateway(),
+			"bugx_drift_report":          resourceDriftReport(),
+			"bugx_export":                resourceExport(),
+			"bugx_gitops_app":            resourceGitOpsApp(),
+			"bugx_guest_access_link":     resourceGuestAccessLink(),
+			"bugx_helm_release":          resourceHelmRelease(),
+			"bugx_ingress_rule":          resourceIngressRule(),
+			"bugx_label_policy":          resourceLabelPolicy(),
+			"bugx_license":               resourceLicense(),
+			"bugx_limit_range":           resourceLimitRange(),
+			"bugx_maintenance_window":    resourceMaintenanceWindow(),
+			"bugx_node_pool":             resourceNodePool(),
+			"bugx_orphan_cleanup":        resourceOrphanCleanup(),
+			"bugx_pod_disruption_budget": resourcePodDisruptionBudget(),
+			"bugx_quota":                 resourceQuota(),
+			"bugx_report_schedule":       resourceReportSchedule(),
+			"bugx_role_binding":          resourceRoleBinding(),
+			"bugx_secret":                resourceSecret(),
+			"bugx_silences":              resourceSilences(),
+			"bugx_snapshot":              resourceSnapshot(),
+			"bugx_sso_config":            resourceSSOConfig(),
+			"bugx_storage_class_binding": resourceStorageClassBinding(),
+			"bugx_team":                  resourceTeam(),
+			"bugx_tunnel":                resourceTunnel(),
+			"bugx_user":                  resourceUser(),
+			"bugx_webhook":               resourceWebhook(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"bugx_cluster": dataSourceCluster(),
+			"bugx_api_diagnostics":      dataSourceAPIDiagnostics(),
+			"bugx_app_catalog":          dataSourceAppCatalog(),
+			"bugx_apps":                 dataSourceApps(),
+			"bugx_cluster":              dataSourceCluster(),
+			"bugx_cluster_by_id":        dataSourceClusterByID(),
+			"bugx_cluster_health":       dataSourceClusterHealth(),
+			"bugx_current_user":         dataSourceCurrentUser(),
+			"bugx_helm_chart_versions":  dataSourceHelmChartVersions(),
+			"bugx_helm_releases":        dataSourceHelmReleases(),
+			"bugx_kubeconfig":           dataSourceKubeconfig(),
+			"bugx_login":                dataSourceLogin(),
+			"bugx_metrics":              dataSourceMetrics(),
+			"bugx_quota_usage":          dataSourceQuotaUsage(),
+			"bugx_secrets":              dataSourceSecrets(),
+			"bugx_supported_helm_repos": dataSourceSupportedHelmRepos(),
+			"bugx_users":                dataSourceUsers(),
+			"bugx_whoami":               dataSourceWhoami(),
 		},
 		ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-			baseURL := "https://bugx.ir" //"http://localhost"
+			baseURL := strings.TrimSuffix(d.Get("base_url").(string), "/")
 			username := d.Get("username").(string)
 			password := d.Get("password").(string)
 
@@ -86,13 +280,30 @@ func Provider() *schema.Provider {
 			}
 
 			// Create HTTP client with proper timeouts
-			httpClient := &http.Client{
-				Timeout: time.Duration(timeoutSeconds) * time.Second,
-				Transport: &http.Transport{
-					IdleConnTimeout:       90 * time.Second,
-					TLSHandshakeTimeout:   10 * time.Second,
-					ExpectContinueTimeout: 1 * time.Second,
+			rateLimit := &rateLimitState{}
+			var transport http.RoundTripper = &rateLimitTransport{
+				base: &gzipTransport{
+					base: &http.Transport{
+						IdleConnTimeout:       90 * time.Second,
+						TLSHandshakeTimeout:   10 * time.Second,
+						ExpectContinueTimeout: 1 * time.Second,
+					},
 				},
+				state: rateLimit,
+			}
+			// Revalidate list responses with ETags instead of transferring them again.
+			transport = &conditionalGetTransport{base: transport}
+
+			// Share the cluster list between resources; writes clear it.
+			var clusters *clusterCache
+			if ttl := d.Get("cluster_cache_ttl").(int); ttl > 0 {
+				clusters = &clusterCache{ttl: time.Duration(ttl) * time.Second}
+				transport = &clusterCacheTransport{base: transport, cache: clusters}
+			}
+
+			httpClient := &http.Client{
+				Timeout:   time.Duration(timeoutSeconds) * time.Second,
+				Transport: transport,
 			}
 
 			// Configure retry settings
@@ -107,44 +318,114 @@ func Provider() *schema.Provider {
 				BaseURL:     baseURL,
 				HTTPClient:  httpClient,
 				RetryConfig: retryConfig,
-			}
+				TestMode:    d.Get("test_mode").(bool),
+				RateLimit:   rateLimit,
+				clusters:    clusters,
 
-			// Perform login to obtain token.
-			reqBody, err := json.Marshal(loginRequest{
-				Username: username,
-				Password: password,
-			})
-			if err != nil {
-				return nil, diag.FromErr(err)
-			}
+				APICompatibility: d.Get("api_compatibility").(string),
+				IdempotencyKeys:  d.Get("idempotency_keys").(bool),
 
-			req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/login", baseURL), bytes.NewReader(reqBody))
-			if err != nil {
-				return nil, diag.FromErr(err)
+				PreflightChecks: d.Get("preflight_checks").(bool),
+				Strict:          d.Get("strict").(bool),
+
+				RequireManagedBy: d.Get("require_managed_by").(bool),
 			}
-			req.Header.Set("Content-Type", "application/json")
 
-			resp, err := httpClient.Do(req)
-			if err != nil {
-				return nil, diag.FromErr(err)
+			if tmpl := d.Get("managed_by").(string); tmpl != "" {
+				stamp, err := renderManagedBy(tmpl, d.Get("state_path").(string))
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+				client.ManagedBy = stamp
 			}
-			defer resp.Body.Close()
 
-			if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-				b, _ := io.ReadAll(resp.Body)
-				return nil, diag.Errorf("login failed: %s: %s", resp.Status, string(b))
+			if blocks := d.Get("secret_encryption").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+				cfg := blocks[0].(map[string]interface{})
+				client.SecretEncryption = &secretEncryptionConfig{
+					KMSKeyID:     cfg["kms_key_id"].(string),
+					PublicKeyPEM: cfg["public_key_pem"].(string),
+				}
+			}
+			changeKey := d.Get("secret_change_detection_key").(string)
+			if changeKey == "" {
+				changeKey = password
 			}
+			setSecretChangeKey(changeKey)
 
-			var lr loginResponse
-			if err := json.NewDecoder(resp.Body).Decode(&lr); err != nil {
-				return nil, diag.FromErr(err)
+			// Perform login to obtain token.
+			client.credentials = loginRequest{
+				Username: username,
+				Password: password,
 			}
-			if lr.Token == "" {
-				return nil, diag.Errorf("login succeeded but no token returned")
+			lr, diags := login(ctx, httpClient, baseURL, client.credentials)
+			if diags.HasError() {
+				return nil, diags
 			}
 
 			client.Token = lr.Token
-			return client, nil
+			client.TokenExpiry = tokenExpiry(lr)
+			return client, diags
 		},
 	}
 }
+
+// login exchanges credentials for a token via POST /login.
+func login(ctx context.Context, httpClient *http.Client, baseURL string, creds loginRequest) (loginResponse, diag.Diagnostics) {
+	var lr loginResponse
+
+	reqBody, err := json.Marshal(creds)
+	if err != nil {
+		return lr, diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/login", baseURL), bytes.NewReader(reqBody))
+	if err != nil {
+		return lr, diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return lr, diag.FromErr(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return lr, diag.Errorf("login failed: %s: %s", resp.Status, string(b))
+	}
+
+	if err := decodeAPIResponse(resp, &lr); err != nil {
+		return lr, diag.FromErr(err)
+	}
+	if lr.Token == "" {
+		return lr, diag.Errorf("login succeeded but no token returned")
+	}
+	return lr, apiSchemaVersionDiags(resp)
+}
+
+// tokenExpiry returns the session expiry reported by /login, falling back to the
+// exp claim when the token is a JWT. A zero time means the expiry is unknown.
+func tokenExpiry(lr loginResponse) time.Time {
+	if lr.ExpiresAt != "" {
+		if t, err := time.Parse(time.RFC3339, lr.ExpiresAt); err == nil {
+			return t
+		}
+	}
+
+	parts := strings.Split(strings.TrimPrefix(lr.Token, "Bearer "), ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(claimsJSON, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0).UTC()
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// APITokenPayload represents the JSON body sent to create/update API tokens.
+type APITokenPayload struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Scopes      []string `json:"scopes"`
+	ExpiresAt   string   `json:"expiresAt,omitempty"`
+}
+
+// APITokenInfo represents the JSON structure returned from the tokens API.
+type APITokenInfo struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Scopes      []string `json:"scopes"`
+	ExpiresAt   string   `json:"expiresAt"`
+	Token       string   `json:"token,omitempty"` // Only returned on create
+	TokenPrefix string   `json:"tokenPrefix"`
+	CreatedAt   string   `json:"createdAt"`
+	LastUsedAt  string   `json:"lastUsedAt"`
+}
+
+// resourceAPIToken defines the bugx_api_token resource schema and CRUD.
+// API tokens are service-account credentials for CI systems; the secret is only
+// returned by the API once, when the token is created.
+func resourceAPIToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPITokenCreate,
+		ReadContext:   resourceAPITokenRead,
+		UpdateContext: resourceAPITokenUpdate,
+		DeleteContext: resourceAPITokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the token, e.g. the CI system it was issued to",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Free-form description of the token",
+			},
+			"scopes": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Scopes the token is restricted to (e.g., 'clusters:read', 'helm:write')",
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+				Description:      "Time the token expires, in RFC 3339 format (e.g., '2026-12-31T00:00:00Z'). If empty, the token does not expire",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token secret. Only returned when the token is created",
+			},
+			"token_prefix": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Non-secret prefix of the token, shown in the platform console to identify it",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the token was created",
+			},
+			"last_used_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the token was last used, if ever",
+			},
+		},
+	}
+}
+
+// buildAPITokenPayload converts Terraform state to API payload.
+func buildAPITokenPayload(d *schema.ResourceData) APITokenPayload {
+	payload := APITokenPayload{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ExpiresAt:   d.Get("expires_at").(string),
+	}
+
+	if scopes, ok := d.Get("scopes").(*schema.Set); ok {
+		for _, s := range scopes.List() {
+			payload.Scopes = append(payload.Scopes, s.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceAPITokenCreate calls POST /auth/api/v1/tokens.
+func resourceAPITokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildAPITokenPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/auth/api/v1/tokens", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create API token failed: %s: %s", resp.Status, string(b))
+	}
+
+	var token APITokenInfo
+	if err := decodeAPIResponse(resp, &token); err != nil {
+		return diag.Errorf("failed to decode create API token response: %v", err)
+	}
+	if token.ID == "" {
+		return diag.Errorf("create API token succeeded but no id returned")
+	}
+
+	d.SetId(token.ID)
+	// The secret is only returned once; Read keeps the stored value.
+	_ = d.Set("token", token.Token)
+	log.Printf("[INFO] created API token %s", token.ID)
+	return resourceAPITokenRead(ctx, d, m)
+}
+
+// resourceAPITokenRead calls GET /auth/api/v1/tokens/:id.
+func resourceAPITokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	token, err := fetchAPITokenByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if token == nil {
+		// API token not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", token.Name)
+	_ = d.Set("description", token.Description)
+	_ = d.Set("scopes", token.Scopes)
+	_ = d.Set("expires_at", token.ExpiresAt)
+	_ = d.Set("token_prefix", token.TokenPrefix)
+	_ = d.Set("created_at", token.CreatedAt)
+	_ = d.Set("last_used_at", token.LastUsedAt)
+
+	return nil
+}
+
+// resourceAPITokenUpdate calls PUT /auth/api/v1/tokens/:id.
+func resourceAPITokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildAPITokenPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/auth/api/v1/tokens/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update API token failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceAPITokenRead(ctx, d, m)
+}
+
+// resourceAPITokenDelete calls DELETE /auth/api/v1/tokens/:id.
+func resourceAPITokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/auth/api/v1/tokens/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] API token %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete API token failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted API token %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchAPITokenByID queries GET /auth/api/v1/tokens/:id and returns the API token.
+func fetchAPITokenByID(ctx context.Context, client *apiClient, id string) (*APITokenInfo, error) {
+	u := fmt.Sprintf("%s/auth/api/v1/tokens/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API token fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var token APITokenInfo
+	if err := decodeAPIResponse(resp, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
@@ -0,0 +1,379 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CatalogAppPayload represents the JSON body sent to install/update catalog apps.
+type CatalogAppPayload struct {
+	Name        string `json:"name"`
+	ClusterName string `json:"clusterName"`
+	App         string `json:"app"`
+	Version     string `json:"version,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Values      string `json:"values,omitempty"` // YAML configuration, validated against the app's schema by the API
+}
+
+// CatalogAppInfo represents the JSON structure returned from the catalog installations API.
+type CatalogAppInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ClusterName string `json:"clusterName"`
+	App         string `json:"app"`
+	Version     string `json:"version"`
+	Namespace   string `json:"namespace"`
+	Values      string `json:"values"`
+	Status      string `json:"status"`
+	Message     string `json:"message"`
+}
+
+// resourceCatalogApp defines the bugx_app resource schema and CRUD.
+// Apps are installed from the platform's curated catalog; unlike bugx_helm_release, the
+// platform picks the chart and validates the configuration against the app's schema.
+func resourceCatalogApp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCatalogAppCreate,
+		ReadContext:   resourceCatalogAppRead,
+		UpdateContext: resourceCatalogAppUpdate,
+		DeleteContext: resourceCatalogAppDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the installation, unique within the cluster",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster to install the app into",
+			},
+			"app": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the app in the catalog (e.g., 'postgresql', 'grafana')",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Catalog version of the app. If empty, the latest version is installed and then kept",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace to install the app into. Defaults to the app's own namespace",
+			},
+			"values": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateYAML,
+				DiffSuppressFunc: suppressEquivalentYAMLDiff,
+				Description:      "App configuration as a YAML string. The accepted keys are listed in the app's catalog entry",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Installation status reported by the API (e.g., 'running', 'failed')",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last status message reported by the API, e.g. the reason an installation failed",
+			},
+		},
+	}
+}
+
+// catalogAppWaitTimeout bounds how long create and update wait for an app to run.
+const catalogAppWaitTimeout = 15 * time.Minute
+
+// buildCatalogAppPayload converts Terraform state to API payload.
+func buildCatalogAppPayload(d *schema.ResourceData) CatalogAppPayload {
+	return CatalogAppPayload{
+		Name:        d.Get("name").(string),
+		ClusterName: d.Get("cluster_name").(string),
+		App:         d.Get("app").(string),
+		Version:     d.Get("version").(string),
+		Namespace:   d.Get("namespace").(string),
+		Values:      d.Get("values").(string),
+	}
+}
+
+// waitForCatalogApp polls the installation until the API reports it running.
+func waitForCatalogApp(ctx context.Context, client *apiClient, id string) error {
+	var message string
+	_, err := waitFor(ctx, func(ctx context.Context) (string, bool, error) {
+		app, err := fetchCatalogAppByID(ctx, client, id)
+		if err != nil {
+			return "", false, err
+		}
+		if app == nil {
+			return "", false, permanentWaitError(fmt.Errorf("app %s disappeared while waiting for it", id))
+		}
+		message = app.Message
+		return app.Status, app.Status == "running", nil
+	}, WaitConfig{
+		Timeout:           catalogAppWaitTimeout,
+		InitialInterval:   5 * time.Second,
+		MaxInterval:       30 * time.Second,
+		BackoffMultiplier: 1.5,
+		FailureStates:     []string{"failed"},
+		OnProgress: func(attempt int, state string, err error) {
+			if err == nil && state != "" {
+				log.Printf("[INFO] app %s status: %s", id, state)
+			}
+		},
+	})
+	if err != nil && message != "" {
+		return fmt.Errorf("%w: %s", err, message)
+	}
+	return err
+}
+
+// resourceCatalogAppCreate calls POST /catalog/api/v1/installations.
+func resourceCatalogAppCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildCatalogAppPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/catalog/api/v1/installations", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create app failed: %s: %s", resp.Status, string(b))
+	}
+
+	var app CatalogAppInfo
+	if err := decodeAPIResponse(resp, &app); err != nil {
+		return diag.Errorf("failed to decode create app response: %v", err)
+	}
+	if app.ID == "" {
+		return diag.Errorf("create app succeeded but no id returned")
+	}
+
+	d.SetId(app.ID)
+
+	if err := waitForCatalogApp(ctx, client, app.ID); err != nil {
+		if isInterrupted(err) {
+			return interruptedDiags(
+				fmt.Sprintf("Interrupted while waiting for app %s to start", app.ID),
+				"The app is recorded in state as tainted and will be reinstalled on the next apply.",
+			)
+		}
+		return diag.Errorf("app %s did not start: %v", app.ID, err)
+	}
+	log.Printf("[INFO] created app %s", app.ID)
+	return resourceCatalogAppRead(ctx, d, m)
+}
+
+// resourceCatalogAppRead calls GET /catalog/api/v1/installations/:id.
+func resourceCatalogAppRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	app, err := fetchCatalogAppByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if app == nil {
+		// App not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", app.Name)
+	_ = d.Set("cluster_name", app.ClusterName)
+	_ = d.Set("app", app.App)
+	_ = d.Set("version", app.Version)
+	_ = d.Set("namespace", app.Namespace)
+	_ = d.Set("values", app.Values)
+	_ = d.Set("status", app.Status)
+	_ = d.Set("message", app.Message)
+
+	return nil
+}
+
+// resourceCatalogAppUpdate calls PUT /catalog/api/v1/installations/:id.
+func resourceCatalogAppUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildCatalogAppPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/catalog/api/v1/installations/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update app failed: %s: %s", resp.Status, string(b))
+	}
+
+	if err := waitForCatalogApp(ctx, client, d.Id()); err != nil {
+		return diag.Errorf("app %s did not start after update: %v", d.Id(), err)
+	}
+
+	return resourceCatalogAppRead(ctx, d, m)
+}
+
+// resourceCatalogAppDelete calls DELETE /catalog/api/v1/installations/:id.
+func resourceCatalogAppDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/catalog/api/v1/installations/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] app %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete app failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted app %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchCatalogAppByID queries GET /catalog/api/v1/installations/:id and returns the app.
+func fetchCatalogAppByID(ctx context.Context, client *apiClient, id string) (*CatalogAppInfo, error) {
+	u := fmt.Sprintf("%s/catalog/api/v1/installations/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("app fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var app CatalogAppInfo
+	if err := decodeAPIResponse(resp, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
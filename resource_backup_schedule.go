@@ -0,0 +1,412 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// BackupTarget represents where backups are stored. An empty Type means the
+// platform's own backup storage.
+type BackupTarget struct {
+	Type     string `json:"type"`
+	Bucket   string `json:"bucket,omitempty"`
+	Prefix   string `json:"prefix,omitempty"`
+	Region   string `json:"region,omitempty"`
+	Endpoint string `json:"endpoint,omitempty"`
+	SecretID string `json:"secretId,omitempty"`
+}
+
+// BackupSchedulePayload represents the JSON body sent to create/update backup schedules.
+type BackupSchedulePayload struct {
+	ClusterName    string        `json:"clusterName"`
+	Schedule       string        `json:"schedule"`
+	RetentionCount int           `json:"retentionCount"`
+	Target         *BackupTarget `json:"target,omitempty"`
+	Enabled        bool          `json:"enabled"`
+}
+
+// BackupScheduleInfo represents the JSON structure returned from the backup schedules API.
+type BackupScheduleInfo struct {
+	ID               string        `json:"id"`
+	ClusterName      string        `json:"clusterName"`
+	Schedule         string        `json:"schedule"`
+	RetentionCount   int           `json:"retentionCount"`
+	Target           *BackupTarget `json:"target,omitempty"`
+	Enabled          bool          `json:"enabled"`
+	LastBackupAt     string        `json:"lastBackupAt"`
+	LastBackupStatus string        `json:"lastBackupStatus"`
+	NextBackupAt     string        `json:"nextBackupAt"`
+}
+
+// resourceBackupSchedule defines the bugx_backup_schedule resource schema and CRUD.
+// The platform backs up the cluster on the schedule and keeps the newest backups.
+func resourceBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupScheduleCreate,
+		ReadContext:   resourceBackupScheduleRead,
+		UpdateContext: resourceBackupScheduleUpdate,
+		DeleteContext: resourceBackupScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceBackupScheduleDiff,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster to back up",
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Cron expression for when backups are taken (e.g., '0 2 * * *' for daily at 02:00)",
+			},
+			"retention_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 365),
+				Description:  "Number of most recent backups to keep (default: 7)",
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Where backups are stored. If omitted, the platform's own backup storage is used",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"platform", "s3"}, false),
+							Description:  "Storage type: 'platform' or 's3'",
+						},
+						"bucket": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Bucket name. Required for 's3'",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Key prefix inside the bucket",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Bucket region",
+						},
+						"endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "Custom endpoint for S3-compatible storage",
+						},
+						"secret_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of a bugx_secret holding the storage credentials (keys 'access_key_id' and 'secret_access_key')",
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether scheduled backups are active (default: true)",
+			},
+			"last_backup_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the most recent backup",
+			},
+			"last_backup_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the most recent backup (e.g., 'succeeded', 'failed')",
+			},
+			"next_backup_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the next scheduled backup",
+			},
+		},
+	}
+}
+
+// buildBackupSchedulePayload converts Terraform state to API payload.
+func buildBackupSchedulePayload(d *schema.ResourceData) BackupSchedulePayload {
+	payload := BackupSchedulePayload{
+		ClusterName:    d.Get("cluster_name").(string),
+		Schedule:       d.Get("schedule").(string),
+		RetentionCount: d.Get("retention_count").(int),
+		Enabled:        d.Get("enabled").(bool),
+	}
+
+	if blocks := d.Get("target").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		t := blocks[0].(map[string]interface{})
+		payload.Target = &BackupTarget{
+			Type:     t["type"].(string),
+			Bucket:   t["bucket"].(string),
+			Prefix:   t["prefix"].(string),
+			Region:   t["region"].(string),
+			Endpoint: t["endpoint"].(string),
+			SecretID: t["secret_id"].(string),
+		}
+	}
+
+	return payload
+}
+
+// flattenBackupTarget converts an API backup target to Terraform state. A nil target,
+// the platform's own storage, becomes no block.
+func flattenBackupTarget(t *BackupTarget) []interface{} {
+	if t == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"type":      t.Type,
+		"bucket":    t.Bucket,
+		"prefix":    t.Prefix,
+		"region":    t.Region,
+		"endpoint":  t.Endpoint,
+		"secret_id": t.SecretID,
+	}}
+}
+
+// resourceBackupScheduleDiff requires a bucket and credentials for s3 targets.
+func resourceBackupScheduleDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("target.0.type").(string) != "s3" {
+		return nil
+	}
+	for _, key := range []string{"bucket", "secret_id"} {
+		if !d.NewValueKnown("target.0." + key) {
+			continue
+		}
+		if d.Get("target.0."+key).(string) == "" {
+			return fmt.Errorf("target.0.%s is required when target type is \"s3\"", key)
+		}
+	}
+	return nil
+}
+
+// resourceBackupScheduleCreate calls POST /backup/api/v1/schedules.
+func resourceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildBackupSchedulePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/backup/api/v1/schedules", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create backup schedule failed: %s: %s", resp.Status, string(b))
+	}
+
+	var schedule BackupScheduleInfo
+	if err := decodeAPIResponse(resp, &schedule); err != nil {
+		return diag.Errorf("failed to decode create backup schedule response: %v", err)
+	}
+	if schedule.ID == "" {
+		return diag.Errorf("create backup schedule succeeded but no id returned")
+	}
+
+	d.SetId(schedule.ID)
+	log.Printf("[INFO] created backup schedule %s", schedule.ID)
+	return resourceBackupScheduleRead(ctx, d, m)
+}
+
+// resourceBackupScheduleRead calls GET /backup/api/v1/schedules/:id.
+func resourceBackupScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	schedule, err := fetchBackupScheduleByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if schedule == nil {
+		// Backup schedule not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", schedule.ClusterName)
+	_ = d.Set("schedule", schedule.Schedule)
+	_ = d.Set("retention_count", schedule.RetentionCount)
+	_ = d.Set("target", flattenBackupTarget(schedule.Target))
+	_ = d.Set("enabled", schedule.Enabled)
+	_ = d.Set("last_backup_at", schedule.LastBackupAt)
+	_ = d.Set("last_backup_status", schedule.LastBackupStatus)
+	_ = d.Set("next_backup_at", schedule.NextBackupAt)
+
+	return nil
+}
+
+// resourceBackupScheduleUpdate calls PUT /backup/api/v1/schedules/:id.
+func resourceBackupScheduleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildBackupSchedulePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/backup/api/v1/schedules/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update backup schedule failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceBackupScheduleRead(ctx, d, m)
+}
+
+// resourceBackupScheduleDelete calls DELETE /backup/api/v1/schedules/:id.
+func resourceBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/backup/api/v1/schedules/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] backup schedule %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete backup schedule failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted backup schedule %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchBackupScheduleByID queries GET /backup/api/v1/schedules/:id and returns the backup schedule.
+func fetchBackupScheduleByID(ctx context.Context, client *apiClient, id string) (*BackupScheduleInfo, error) {
+	u := fmt.Sprintf("%s/backup/api/v1/schedules/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("backup schedule fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var schedule BackupScheduleInfo
+	if err := decodeAPIResponse(resp, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
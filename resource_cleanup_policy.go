@@ -0,0 +1,394 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CleanupPolicyPayload represents the JSON body sent to create/update cleanup policies.
+type CleanupPolicyPayload struct {
+	Name            string   `json:"name"`
+	Target          string   `json:"target"` // "apps" or "clusters"
+	TTL             string   `json:"ttl"`
+	Schedule        string   `json:"schedule"`
+	NamePatterns    []string `json:"namePatterns"`
+	ExcludePatterns []string `json:"excludePatterns"`
+	ClusterNames    []string `json:"clusterNames"` // Only for the "apps" target; empty means every cluster
+	DryRun          bool     `json:"dryRun"`
+	Enabled         bool     `json:"enabled"`
+}
+
+// CleanupPolicyInfo represents the JSON structure returned from the cleanup policies API.
+type CleanupPolicyInfo struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Target          string   `json:"target"`
+	TTL             string   `json:"ttl"`
+	Schedule        string   `json:"schedule"`
+	NamePatterns    []string `json:"namePatterns"`
+	ExcludePatterns []string `json:"excludePatterns"`
+	ClusterNames    []string `json:"clusterNames"`
+	DryRun          bool     `json:"dryRun"`
+	Enabled         bool     `json:"enabled"`
+	LastRunAt       string   `json:"lastRunAt"`
+	LastRunDeleted  []string `json:"lastRunDeleted"`
+	NextRunAt       string   `json:"nextRunAt"`
+}
+
+// resourceCleanupPolicy defines the bugx_cleanup_policy resource schema and CRUD.
+// A cleanup policy makes the platform delete apps or clusters older than a TTL on a recurring
+// schedule, so garbage collection does not depend on anyone running apply.
+func resourceCleanupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCleanupPolicyCreate,
+		ReadContext:   resourceCleanupPolicyRead,
+		UpdateContext: resourceCleanupPolicyUpdate,
+		DeleteContext: resourceCleanupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceCleanupPolicyDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the policy (must be unique)",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"apps", "clusters"}, false),
+				Description:  "What the policy deletes: 'apps' or 'clusters'",
+			},
+			"ttl": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: suppressEquivalentDuration,
+				Description:      "Age after which a matching app or cluster is deleted, as a Go duration (e.g., '168h')",
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0 * * * *",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Cron expression for when the policy runs (default: '0 * * * *', hourly)",
+			},
+			"name_patterns": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+				Description: "Regular expressions matched against app or cluster names. Only matching objects are deleted",
+			},
+			"exclude_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+				Description: "Regular expressions for names that are never deleted, even if they match name_patterns",
+			},
+			"cluster_names": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "For the 'apps' target, only delete apps of these clusters. If empty, apps of every cluster are considered",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only record what the policy would delete in last_run_deleted, without deleting anything (default: false)",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the policy runs (default: true)",
+			},
+			"last_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the most recent run",
+			},
+			"last_run_deleted": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names deleted by the most recent run, or that would have been deleted with dry_run",
+			},
+			"next_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the next scheduled run",
+			},
+		},
+	}
+}
+
+// buildCleanupPolicyPayload converts Terraform state to API payload.
+func buildCleanupPolicyPayload(d *schema.ResourceData) CleanupPolicyPayload {
+	payload := CleanupPolicyPayload{
+		Name:            d.Get("name").(string),
+		Target:          d.Get("target").(string),
+		TTL:             d.Get("ttl").(string),
+		Schedule:        d.Get("schedule").(string),
+		NamePatterns:    []string{},
+		ExcludePatterns: []string{},
+		ClusterNames:    []string{},
+		DryRun:          d.Get("dry_run").(bool),
+		Enabled:         d.Get("enabled").(bool),
+	}
+
+	for _, p := range d.Get("name_patterns").([]interface{}) {
+		payload.NamePatterns = append(payload.NamePatterns, p.(string))
+	}
+	for _, p := range d.Get("exclude_patterns").([]interface{}) {
+		payload.ExcludePatterns = append(payload.ExcludePatterns, p.(string))
+	}
+	if clusters, ok := d.Get("cluster_names").(*schema.Set); ok {
+		for _, c := range clusters.List() {
+			payload.ClusterNames = append(payload.ClusterNames, c.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceCleanupPolicyDiff rejects cluster_names for the clusters target.
+func resourceCleanupPolicyDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("target") || d.Get("target").(string) != "clusters" {
+		return nil
+	}
+	if d.NewValueKnown("cluster_names") && d.Get("cluster_names").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("cluster_names can only be set when target is \"apps\"; use name_patterns to select clusters")
+	}
+	return nil
+}
+
+// resourceCleanupPolicyCreate calls POST /cleanup/api/v1/policies.
+func resourceCleanupPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildCleanupPolicyPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/cleanup/api/v1/policies", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create cleanup policy failed: %s: %s", resp.Status, string(b))
+	}
+
+	var policy CleanupPolicyInfo
+	if err := decodeAPIResponse(resp, &policy); err != nil {
+		return diag.Errorf("failed to decode create cleanup policy response: %v", err)
+	}
+	if policy.ID == "" {
+		return diag.Errorf("create cleanup policy succeeded but no id returned")
+	}
+
+	d.SetId(policy.ID)
+	log.Printf("[INFO] created cleanup policy %s", policy.ID)
+	return resourceCleanupPolicyRead(ctx, d, m)
+}
+
+// resourceCleanupPolicyRead calls GET /cleanup/api/v1/policies/:id.
+func resourceCleanupPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	policy, err := fetchCleanupPolicyByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if policy == nil {
+		// Cleanup policy not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("target", policy.Target)
+	_ = d.Set("ttl", policy.TTL)
+	_ = d.Set("schedule", policy.Schedule)
+	_ = d.Set("name_patterns", policy.NamePatterns)
+	_ = d.Set("exclude_patterns", policy.ExcludePatterns)
+	_ = d.Set("cluster_names", policy.ClusterNames)
+	_ = d.Set("dry_run", policy.DryRun)
+	_ = d.Set("enabled", policy.Enabled)
+	_ = d.Set("last_run_at", policy.LastRunAt)
+	_ = d.Set("last_run_deleted", policy.LastRunDeleted)
+	_ = d.Set("next_run_at", policy.NextRunAt)
+
+	return nil
+}
+
+// resourceCleanupPolicyUpdate calls PUT /cleanup/api/v1/policies/:id.
+func resourceCleanupPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildCleanupPolicyPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/cleanup/api/v1/policies/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update cleanup policy failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceCleanupPolicyRead(ctx, d, m)
+}
+
+// resourceCleanupPolicyDelete calls DELETE /cleanup/api/v1/policies/:id.
+func resourceCleanupPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/cleanup/api/v1/policies/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] cleanup policy %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete cleanup policy failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted cleanup policy %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchCleanupPolicyByID queries GET /cleanup/api/v1/policies/:id and returns the cleanup policy.
+func fetchCleanupPolicyByID(ctx context.Context, client *apiClient, id string) (*CleanupPolicyInfo, error) {
+	u := fmt.Sprintf("%s/cleanup/api/v1/policies/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("cleanup policy fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var policy CleanupPolicyInfo
+	if err := decodeAPIResponse(resp, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
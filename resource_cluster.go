@@ -9,10 +9,14 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
 )
 
 // ClusterPayload represents the JSON body sent to /createcluster.
@@ -32,8 +36,28 @@ type ClusterPayload struct {
 	CoreDNSMemory   string `json:"CoreDNSMemory"`
 	ApiServerCpu    string `json:"ApiServerCpu"`
 	ApiServerMemory string `json:"ApiServerMemory"`
+	ExtraValues     string `json:"ExtraValues,omitempty"` // Optional: raw chart values as YAML string
+
+	IsolationMode       string `json:"IsolationMode,omitempty"`
+	PodSecurityStandard string `json:"PodSecurityStandard,omitempty"`
+
+	ApiServerCpuLimit    string            `json:"ApiServerCpuLimit,omitempty"`
+	ApiServerMemoryLimit string            `json:"ApiServerMemoryLimit,omitempty"`
+	CoreDNSCpuLimit      string            `json:"CoreDNSCpuLimit,omitempty"`
+	CoreDNSMemoryLimit   string            `json:"CoreDNSMemoryLimit,omitempty"`
+	ExtendedResources    map[string]string `json:"ExtendedResources,omitempty"`        // e.g. {"nvidia.com/gpu": "1"} for the control plane
+	CoreDNSExtended      map[string]string `json:"CoreDNSExtendedResources,omitempty"` // extended resources for CoreDNS
+
+	Labels map[string]string `json:"Labels,omitempty"`
+
+	RestoreFromSnapshot string `json:"RestoreFromSnapshot,omitempty"` // Optional: ID of a snapshot to provision the cluster from
+
+	TestMode bool `json:"TestMode,omitempty"` // Request a minimal-footprint mock cluster from the test tier
 }
 
+// clusterIdentityFields are the ClusterInfo fields that identify a cluster in a list response.
+var clusterIdentityFields = []string{"Name", "ClusterID"}
+
 // ClusterInfo represents the JSON structure returned from /clusters.
 type ClusterInfo struct {
 	Name        string `json:"Name"`
@@ -44,6 +68,15 @@ type ClusterInfo struct {
 	Alert       string `json:"Alert"`
 	EndPoint    string `json:"EndPoint"`
 	NameSpace   string `json:"NameSpace"`
+
+	IsolationMode       string `json:"IsolationMode,omitempty"`
+	PodSecurityStandard string `json:"PodSecurityStandard,omitempty"`
+
+	CreationTimestamp string `json:"CreationTimestamp,omitempty"`
+	CreatedBy         string `json:"CreatedBy,omitempty"`
+	LastModified      string `json:"LastModified,omitempty"`
+
+	Labels map[string]string `json:"Labels,omitempty"`
 }
 
 // resourceCluster defines the bugx_cluster resource schema and CRUD.
@@ -54,19 +87,55 @@ func resourceCluster() *schema.Resource {
 		UpdateContext: resourceClusterUpdate,
 		DeleteContext: resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("cluster_id"),
+		},
+		// Identity lets Terraform 1.12+ import blocks address clusters by ClusterID.
+		Identity: &schema.ResourceIdentity{
+			Version: 0,
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"cluster_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "Cluster ID",
+					},
+				}
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(
+			resourceClusterRecreateFailedDiff,
+			resourceClusterPreflightDiff,
+			labelPolicyDiff("cluster"),
+		),
+
+		// Bump SchemaVersion and append a StateUpgrader (see resource_cluster_migrate.go)
+		// whenever an attribute is renamed or changes type.
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceClusterV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceClusterStateUpgradeV0,
+				Version: 0,
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
-			"name":             {Type: schema.TypeString, Required: true},
-			"cluster_id":       {Type: schema.TypeString, Optional: true, Computed: true},
-			"control_plane":    {Type: schema.TypeString, Required: true},
-			"status":           {Type: schema.TypeString, Optional: true, Default: "Progressing"},
+			"name":          {Type: schema.TypeString, Required: true},
+			"cluster_id":    {Type: schema.TypeString, Optional: true, Computed: true},
+			"control_plane": {Type: schema.TypeString, Required: true},
+			"status": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "status is reported by the API and the configured value is ignored. Remove it from the configuration; it will become read-only in the next major version",
+				// Never plan a change from the configured value; the API owns the status.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool { return true },
+			},
 			"cpu":              {Type: schema.TypeString, Required: true},
 			"memory":           {Type: schema.TypeString, Required: true},
 			"platform_version": {Type: schema.TypeString, Required: true},
-			"health_check":     {Type: schema.TypeString, Optional: true},
-			"alert":            {Type: schema.TypeString, Optional: true},
+			"health_check":     {Type: schema.TypeString, Optional: true, Computed: true},
+			"alert":            {Type: schema.TypeString, Optional: true, Computed: true},
 			"endpoint":         {Type: schema.TypeString, Optional: true, Computed: true},
 			"namespace":        {Type: schema.TypeString, Optional: true, Computed: true},
 			"kubeconfig":       {Type: schema.TypeString, Optional: true, Computed: true, Sensitive: true},
@@ -75,10 +144,120 @@ func resourceCluster() *schema.Resource {
 			"coredns_memory":   {Type: schema.TypeString, Required: true},
 			"apiserver_cpu":    {Type: schema.TypeString, Required: true},
 			"apiserver_memory": {Type: schema.TypeString, Required: true},
+			"extra_values": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateYAML,
+				DiffSuppressFunc: suppressEquivalentYAMLDiff,
+				Description:      "Raw chart values as YAML string, passed through to the backend on cluster creation for options not yet modeled by the provider",
+			},
+			"isolation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "isolated"}, false),
+				Description:  "Workload isolation mode: 'standard' or 'isolated'. Isolated clusters get enforced network policies, resource quotas and the restricted Pod Security Standard",
+			},
+			"pod_security_standard": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"privileged", "baseline", "restricted"}, false),
+				Description:  "Pod Security Standard enforced inside the cluster: 'privileged', 'baseline' or 'restricted'",
+			},
+			"apiserver_cpu_limit":    {Type: schema.TypeString, Optional: true, ForceNew: true, Description: "CPU limit for the API server (e.g., '1')"},
+			"apiserver_memory_limit": {Type: schema.TypeString, Optional: true, ForceNew: true, Description: "Memory limit for the API server (e.g., '1Gi')"},
+			"coredns_cpu_limit":      {Type: schema.TypeString, Optional: true, ForceNew: true, Description: "CPU limit for CoreDNS (e.g., '1')"},
+			"coredns_memory_limit":   {Type: schema.TypeString, Optional: true, ForceNew: true, Description: "Memory limit for CoreDNS (e.g., '512Mi')"},
+			"extended_resources": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Extended resource requests for the control plane, keyed by resource name (e.g., 'nvidia.com/gpu' = '1')",
+			},
+			"coredns_extended_resources": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Extended resource requests for CoreDNS, keyed by resource name",
+			},
+			"creation_timestamp": {Type: schema.TypeString, Computed: true, Description: "Time the cluster was created, as reported by the API"},
+			"created_by":         {Type: schema.TypeString, Computed: true, Description: "User that created the cluster"},
+			"last_modified":      {Type: schema.TypeString, Computed: true, Description: "Time the cluster was last modified, as reported by the API"},
+			"managed_by":         {Type: schema.TypeString, Computed: true, Description: "Managed-by stamp carried by the cluster, see the provider's managed_by option"},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels attached to the cluster, e.g. for label policies and inventory",
+			},
+			"restore_from_snapshot": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of a bugx_snapshot to provision the cluster from. Only used on creation",
+			},
+			"auto_recreate_on_failed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Plan a replacement of the cluster when the API reports a terminal 'Failed' status (default: false)",
+			},
 		},
 	}
 }
 
+// resourceClusterRecreateFailedDiff plans a replacement of clusters whose refreshed
+// status is Failed when auto_recreate_on_failed is set.
+func resourceClusterRecreateFailedDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.Get("auto_recreate_on_failed").(bool) {
+		return nil
+	}
+	// The prior value is the refreshed status; a configured status is ignored.
+	if status, _ := d.GetChange("status"); status.(string) != "Failed" {
+		return nil
+	}
+
+	log.Printf("[INFO] cluster %s is in Failed status, planning replacement (auto_recreate_on_failed)", d.Get("name").(string))
+	// The replacement reports a new status, which is what forces it.
+	if err := d.SetNewComputed("status"); err != nil {
+		return err
+	}
+	return d.ForceNew("status")
+}
+
+// resourceClusterPreflightDiff runs plan-time checks for new clusters when the provider's
+// preflight_checks option is enabled.
+func resourceClusterPreflightDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil || !client.PreflightChecks {
+		return nil
+	}
+
+	// Only clusters that are about to be created can collide with an existing name.
+	if d.Id() != "" || !d.NewValueKnown("name") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	info, err := fetchClusterInfo(ctx, client, name)
+	if err != nil {
+		log.Printf("[WARN] preflight: failed to check whether cluster %s already exists: %v", name, err)
+		return nil
+	}
+	if info != nil && info.Name == name {
+		return fmt.Errorf("a cluster named %q already exists (cluster_id: %s) but is not managed by this state; import it with `terraform import` or choose a different name", name, info.ClusterID)
+	}
+
+	return nil
+}
+
 // buildPayload converts Terraform state to API payload.
 func buildPayload(d *schema.ResourceData) ClusterPayload {
 	clusterID := ""
@@ -89,7 +268,7 @@ func buildPayload(d *schema.ResourceData) ClusterPayload {
 		Name:            d.Get("name").(string),
 		ClusterID:       clusterID,
 		ControlPlane:    d.Get("control_plane").(string),
-		Status:          d.Get("status").(string),
+		Status:          "Progressing",
 		Cpu:             d.Get("cpu").(string),
 		Memory:          d.Get("memory").(string),
 		PlatformVersion: d.Get("platform_version").(string),
@@ -101,7 +280,36 @@ func buildPayload(d *schema.ResourceData) ClusterPayload {
 		CoreDNSMemory:   d.Get("coredns_memory").(string),
 		ApiServerCpu:    d.Get("apiserver_cpu").(string),
 		ApiServerMemory: d.Get("apiserver_memory").(string),
+		ExtraValues:     d.Get("extra_values").(string),
+
+		IsolationMode:       d.Get("isolation_mode").(string),
+		PodSecurityStandard: d.Get("pod_security_standard").(string),
+
+		ApiServerCpuLimit:    d.Get("apiserver_cpu_limit").(string),
+		ApiServerMemoryLimit: d.Get("apiserver_memory_limit").(string),
+		CoreDNSCpuLimit:      d.Get("coredns_cpu_limit").(string),
+		CoreDNSMemoryLimit:   d.Get("coredns_memory_limit").(string),
+		ExtendedResources:    expandStringMap(d.Get("extended_resources").(map[string]interface{})),
+		CoreDNSExtended:      expandStringMap(d.Get("coredns_extended_resources").(map[string]interface{})),
+
+		Labels: expandStringMap(d.Get("labels").(map[string]interface{})),
+
+		RestoreFromSnapshot: d.Get("restore_from_snapshot").(string),
+	}
+}
+
+// expandStringMap converts a Terraform map attribute to map[string]string.
+func expandStringMap(in map[string]interface{}) map[string]string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		if strVal, ok := v.(string); ok {
+			out[k] = strVal
+		}
 	}
+	return out
 }
 
 // resourceClusterCreate calls POST /createcluster.
@@ -112,6 +320,8 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	payload := buildPayload(d)
+	payload.Labels = client.stampManagedBy(payload.Labels)
+	payload.TestMode = client.TestMode
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return diag.FromErr(err)
@@ -124,14 +334,8 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	req.Header.Set("Content-Type", "application/json")
 	// Set Authorization header with raw token as provided by the login API usage.
 	req.Header.Set("Authorization", client.Token)
-
-	// Set GetBody for retry support
-	if req.Body != nil {
-		bodyBytes, _ := io.ReadAll(req.Body)
-		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-		req.GetBody = func() (io.ReadCloser, error) {
-			return io.NopCloser(bytes.NewReader(bodyBytes)), nil
-		}
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
@@ -147,72 +351,111 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	// After creating the cluster, poll /clusters?Name=<name> until the Status becomes Healthy.
 	name := payload.Name
-	const (
-		maxAttempts  = 60
-		pollInterval = 10 * time.Second
-	)
 
-	var lastStatus string
-	for i := 0; i < maxAttempts; i++ {
-		info, err := fetchClusterInfo(ctx, client, name)
-		if err != nil {
-			log.Printf("[WARN] failed to fetch cluster %s status: %v", name, err)
-		} else if info != nil {
-			lastStatus = info.Status
-			log.Printf("[INFO] cluster %s status: %s", name, info.Status)
-
-			// Update a few fields in state from the latest info.
-			_ = d.Set("status", info.Status)
-			_ = d.Set("endpoint", info.EndPoint)
-			_ = d.Set("namespace", info.NameSpace)
-			if info.ClusterID != "" {
-				_ = d.Set("cluster_id", info.ClusterID)
+	// Record the cluster right away so that an interrupted or failed wait leaves it in
+	// state (tainted) instead of orphaning it. The ID is replaced by the ClusterID
+	// reported by the API as soon as it is known.
+	if payload.ClusterID != "" {
+		d.SetId(payload.ClusterID)
+	} else {
+		d.SetId(name)
+	}
+	waitConfig := WaitConfig{
+		Timeout: 10 * time.Minute,
+		// Start fast so quickly provisioned clusters finish sooner, then back off to
+		// keep the API load of slow ones low.
+		InitialInterval:   2 * time.Second,
+		MaxInterval:       30 * time.Second,
+		BackoffMultiplier: 1.5,
+		FailureStates:     []string{"Failed"},
+		OnProgress: func(attempt int, state string, err error) {
+			if err == nil && state != "" {
+				log.Printf("[INFO] cluster %s status: %s", name, state)
 			}
+		},
+	}
+	if client.TestMode {
+		// Test-tier clusters are mocks; only wait for the backend to register them.
+		waitConfig.Timeout = 1 * time.Minute
+		waitConfig.MaxInterval = 2 * time.Second
+	}
 
-			if info.Status == "Healthy" {
-				// Fetch kubeconfig when cluster is Healthy
-				kubeconfig, err := fetchKubeconfig(ctx, client, name)
-				if err != nil {
-					log.Printf("[WARN] failed to fetch kubeconfig for cluster %s: %v", name, err)
-				} else if kubeconfig != "" {
-					_ = d.Set("kubeconfig", kubeconfig)
-				}
+	// Check as soon as the API pushes a status change, if it offers an event stream;
+	// polling remains the fallback.
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+	wake := make(chan struct{}, 1)
+	go watchEvents(watchCtx, client, fmt.Sprintf("/clusters/events?Name=%s", url.QueryEscape(name)), wake, waitConfig)
+	waitConfig.Wake = wake
 
-				// Call /clusters (without query) to get the namespace
-				allClusters, err := fetchAllClusters(ctx, client)
-				if err != nil {
-					log.Printf("[WARN] failed to fetch all clusters to get namespace: %v", err)
-				} else {
-					// Find the cluster by name in the list
-					for _, cluster := range allClusters {
-						if cluster.Name == name && cluster.NameSpace != "" {
-							_ = d.Set("namespace", cluster.NameSpace)
-							log.Printf("[INFO] set cluster namespace to %s", cluster.NameSpace)
-							break
-						}
-					}
-				}
+	var info *ClusterInfo
+	_, err = waitFor(ctx, func(ctx context.Context) (string, bool, error) {
+		latest, err := fetchClusterInfo(ctx, client, name)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to fetch cluster %s status: %w", name, err)
+		}
+		if latest == nil {
+			return "", false, nil
+		}
+		info = latest
+
+		// Update a few fields in state from the latest info.
+		_ = d.Set("status", info.Status)
+		_ = d.Set("endpoint", info.EndPoint)
+		_ = d.Set("namespace", info.NameSpace)
+		_ = d.Set("health_check", info.HealthCheck)
+		_ = d.Set("alert", info.Alert)
+		if info.ClusterID != "" {
+			_ = d.Set("cluster_id", info.ClusterID)
+			d.SetId(info.ClusterID)
+		}
 
-				// Use ClusterID as Terraform resource ID (from payload or info).
-				if info.ClusterID != "" {
-					d.SetId(info.ClusterID)
-				} else {
-					d.SetId(payload.ClusterID)
-				}
-				return resourceClusterRead(ctx, d, m)
-			}
+		return info.Status, info.Status == "Healthy" || client.TestMode, nil
+	}, waitConfig)
+	stopWatch()
+	if err != nil {
+		if isInterrupted(err) {
+			return interruptedDiags(
+				fmt.Sprintf("Interrupted while waiting for cluster %s to become Healthy", name),
+				fmt.Sprintf("Cluster %s (id: %s) was created and is recorded in state as tainted. To keep it instead of recreating it, run `terraform untaint` on this resource and then `terraform apply` again; the next refresh picks up its current status.", name, d.Id()),
+			)
+		}
+		return diag.Errorf("cluster %s did not become Healthy: %v", name, err)
+	}
+
+	// Fetch kubeconfig when cluster is Healthy. The endpoint often lags behind the
+	// Healthy status, so retry on its own schedule.
+	kubeconfigTimeout := kubeconfigWaitTimeout
+	if client.TestMode {
+		kubeconfigTimeout = 10 * time.Second
+	}
+	kubeconfig, err := waitForKubeconfig(ctx, client, name, kubeconfigTimeout)
+	if err != nil {
+		if diags := client.softFailure("failed to fetch kubeconfig for cluster %s: %v", name, err); diags.HasError() {
+			return diags
 		}
+	} else if kubeconfig != "" {
+		_ = d.Set("kubeconfig", kubeconfig)
+	}
 
-		if i < maxAttempts-1 {
-			select {
-			case <-ctx.Done():
-				return diag.FromErr(ctx.Err())
-			case <-time.After(pollInterval):
+	// Call /clusters (without query) to get the namespace
+	allClusters, err := fetchAllClusters(ctx, client)
+	if err != nil {
+		if diags := client.softFailure("failed to fetch all clusters to get namespace of cluster %s: %v", name, err); diags.HasError() {
+			return diags
+		}
+	} else {
+		// Find the cluster by name in the list
+		for _, cluster := range allClusters {
+			if cluster.Name == name && cluster.NameSpace != "" {
+				_ = d.Set("namespace", cluster.NameSpace)
+				log.Printf("[INFO] set cluster namespace to %s", cluster.NameSpace)
+				break
 			}
 		}
 	}
 
-	return diag.Errorf("cluster %s did not become Healthy within the timeout; last known status: %s", name, lastStatus)
+	return resourceClusterRead(ctx, d, m)
 }
 
 // resourceClusterRead reads cluster information from the API
@@ -261,15 +504,34 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 	_ = d.Set("status", info.Status)
 	_ = d.Set("endpoint", info.EndPoint)
 	_ = d.Set("namespace", info.NameSpace)
+	_ = d.Set("health_check", info.HealthCheck)
+	_ = d.Set("alert", info.Alert)
 	if info.ClusterID != "" {
 		_ = d.Set("cluster_id", info.ClusterID)
 	}
+	if err := setClusterIdentity(d); err != nil {
+		if diags := client.softFailure("failed to set identity for cluster %s: %v", name, err); diags.HasError() {
+			return diags
+		}
+	}
+	if info.IsolationMode != "" {
+		_ = d.Set("isolation_mode", info.IsolationMode)
+	}
+	if info.PodSecurityStandard != "" {
+		_ = d.Set("pod_security_standard", info.PodSecurityStandard)
+	}
+	_ = d.Set("creation_timestamp", info.CreationTimestamp)
+	_ = d.Set("created_by", info.CreatedBy)
+	_ = d.Set("last_modified", info.LastModified)
+	_ = d.Set("managed_by", info.Labels[managedByKey])
 
 	// Fetch kubeconfig if cluster is Healthy
 	if info.Status == "Healthy" {
 		kubeconfig, err := fetchKubeconfig(ctx, client, name)
 		if err != nil {
-			log.Printf("[WARN] failed to fetch kubeconfig for cluster %s: %v", name, err)
+			if diags := client.softFailure("failed to fetch kubeconfig for cluster %s: %v", name, err); diags.HasError() {
+				return diags
+			}
 		} else if kubeconfig != "" {
 			_ = d.Set("kubeconfig", kubeconfig)
 		}
@@ -278,6 +540,18 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 	return nil
 }
 
+// setClusterIdentity records the resource ID as the cluster_id identity attribute.
+func setClusterIdentity(d *schema.ResourceData) error {
+	if d.Id() == "" {
+		return nil
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return identity.Set("cluster_id", d.Id())
+}
+
 // resourceClusterUpdate is a stub; you can extend it to call an update endpoint.
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// TODO: Implement update behavior when API supports it.
@@ -300,13 +574,20 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return nil
 	}
 
-	if namespace == "" {
-		// Try to fetch the namespace from the API if we don't have it stored
+	if namespace == "" || client.RequireManagedBy {
+		// Fetch the namespace from the API if we don't have it stored, and the stamp to verify
 		info, err := fetchClusterInfo(ctx, client, name)
 		if err != nil {
-			log.Printf("[WARN] failed to fetch cluster %s info for delete: %v", name, err)
-		} else if info != nil && info.NameSpace != "" {
-			namespace = info.NameSpace
+			if diags := client.softFailure("failed to fetch cluster %s info for delete: %v", name, err); diags.HasError() {
+				return diags
+			}
+		} else if info != nil {
+			if diags := client.verifyManagedBy("cluster", name, info.Labels[managedByKey]); diags.HasError() {
+				return diags
+			}
+			if namespace == "" && info.NameSpace != "" {
+				namespace = info.NameSpace
+			}
 		}
 	}
 
@@ -337,7 +618,9 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 		log.Printf("[WARN] delete request returned error, verifying cluster deletion...")
 
 		// Wait a moment for the deletion to complete
-		time.Sleep(2 * time.Second)
+		if err := sleepContext(ctx, 2*time.Second); err != nil {
+			return diags
+		}
 
 		// Check if cluster still exists
 		info, checkErr := fetchClusterInfo(ctx, client, name)
@@ -345,7 +628,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 			log.Printf("[WARN] failed to verify cluster deletion: %v", checkErr)
 		}
 
-		if info == nil {
+		if checkErr == nil && info == nil {
 			// Cluster is gone, deletion was successful despite the connection error
 			log.Printf("[INFO] cluster %s successfully deleted (verified)", name)
 			d.SetId("")
@@ -377,7 +660,9 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 		}
 		// Even if status code indicates error, verify the cluster is actually gone
 		log.Printf("[WARN] delete returned status %s, verifying cluster deletion...", resp.Status)
-		time.Sleep(2 * time.Second)
+		if err := sleepContext(ctx, 2*time.Second); err != nil {
+			return diag.Errorf("deletecluster failed: %s: %s", resp.Status, bodyStr)
+		}
 		info, checkErr := fetchClusterInfo(ctx, client, name)
 		if checkErr == nil && info == nil {
 			// Cluster is gone, deletion was successful
@@ -393,8 +678,18 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 	return nil
 }
 
-// fetchAllClusters queries /clusters (without query parameter) and returns all clusters.
+// fetchAllClusters returns all clusters, served from the cluster cache when it is enabled.
 func fetchAllClusters(ctx context.Context, client *apiClient) ([]ClusterInfo, error) {
+	if client.clusters == nil {
+		return requestAllClusters(ctx, client)
+	}
+	return client.clusters.load(ctx, func(ctx context.Context) ([]ClusterInfo, error) {
+		return requestAllClusters(ctx, client)
+	})
+}
+
+// requestAllClusters queries /clusters (without query parameter) and returns all clusters.
+func requestAllClusters(ctx context.Context, client *apiClient) ([]ClusterInfo, error) {
 	u := fmt.Sprintf("%s/clusters", client.BaseURL)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
@@ -424,14 +719,29 @@ func fetchAllClusters(ctx context.Context, client *apiClient) ([]ClusterInfo, er
 	}
 
 	var list []ClusterInfo
-	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+	if err := decodeAPIList(resp, client.APICompatibility, "", clusterIdentityFields, &list); err != nil {
 		return nil, err
 	}
 	return list, nil
 }
 
 // fetchClusterInfo queries /clusters?Name=<name> and returns the first matching cluster info.
+// With the cluster cache enabled, the cluster is looked up in the cached list instead.
 func fetchClusterInfo(ctx context.Context, client *apiClient, name string) (*ClusterInfo, error) {
+	if client.clusters != nil {
+		list, err := fetchAllClusters(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list {
+			if list[i].Name == name {
+				info := list[i]
+				return &info, nil
+			}
+		}
+		return nil, nil
+	}
+
 	u := fmt.Sprintf("%s/clusters?Name=%s", client.BaseURL, url.QueryEscape(name))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
@@ -463,13 +773,16 @@ func fetchClusterInfo(ctx context.Context, client *apiClient, name string) (*Clu
 	}
 
 	var list []ClusterInfo
-	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+	if err := decodeAPIList(resp, client.APICompatibility, "", clusterIdentityFields, &list); err != nil {
 		return nil, err
 	}
-	if len(list) == 0 {
-		return nil, nil
+	// Only trust an item that is really the cluster asked for; the API may ignore the filter.
+	for i := range list {
+		if list[i].Name == name {
+			return &list[i], nil
+		}
 	}
-	return &list[0], nil
+	return nil, nil
 }
 
 // fetchKubeconfig queries /connect?Name=<name> and returns the kubeconfig content.
@@ -501,5 +814,60 @@ func fetchKubeconfig(ctx context.Context, client *apiClient, name string) (strin
 		return "", fmt.Errorf("failed to read kubeconfig response: %w", err)
 	}
 
+	if err := validateKubeconfig(string(body)); err != nil {
+		return "", err
+	}
 	return string(body), nil
 }
+
+// kubeconfigWaitTimeout bounds how long cluster creation waits for a usable kubeconfig.
+const kubeconfigWaitTimeout = 2 * time.Minute
+
+// waitForKubeconfig retries fetchKubeconfig until it returns a valid kubeconfig or timeout expires.
+func waitForKubeconfig(ctx context.Context, client *apiClient, name string, timeout time.Duration) (string, error) {
+	var kubeconfig string
+	_, err := waitFor(ctx, func(ctx context.Context) (string, bool, error) {
+		kc, err := fetchKubeconfig(ctx, client, name)
+		if err != nil {
+			return "", false, err
+		}
+		kubeconfig = kc
+		return "available", true, nil
+	}, WaitConfig{
+		Timeout:           timeout,
+		InitialInterval:   3 * time.Second,
+		MaxInterval:       15 * time.Second,
+		BackoffMultiplier: 2,
+	})
+	return kubeconfig, err
+}
+
+// kubeconfigDocument holds the parts of a kubeconfig that validateKubeconfig checks.
+type kubeconfigDocument struct {
+	Kind     string        `yaml:"kind"`
+	Clusters []interface{} `yaml:"clusters"`
+}
+
+// validateKubeconfig checks that content is a kubeconfig rather than, for example,
+// an HTML error page served while the endpoint is not yet routable.
+func validateKubeconfig(content string) error {
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" {
+		return fmt.Errorf("kubeconfig response is empty")
+	}
+	if strings.HasPrefix(trimmed, "<") {
+		return fmt.Errorf("kubeconfig response is HTML, not a kubeconfig")
+	}
+
+	var doc kubeconfigDocument
+	if err := yaml.Unmarshal([]byte(trimmed), &doc); err != nil {
+		return fmt.Errorf("kubeconfig response does not parse as YAML: %w", err)
+	}
+	if doc.Kind != "" && doc.Kind != "Config" {
+		return fmt.Errorf("kubeconfig response has kind %q, expected Config", doc.Kind)
+	}
+	if len(doc.Clusters) == 0 {
+		return fmt.Errorf("kubeconfig response defines no clusters")
+	}
+	return nil
+}
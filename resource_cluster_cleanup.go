@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceClusterCleanup defines a resource that deletes stale clusters: clusters whose
+// name matches one of name_patterns but that are not in keep_clusters, such as abandoned
+// ephemeral PR environments.
+func resourceClusterCleanup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterCleanupCreate,
+		ReadContext:   resourceClusterCleanupRead,
+		UpdateContext: resourceClusterCleanupUpdate,
+		DeleteContext: resourceClusterCleanupDelete,
+		CustomizeDiff: resourceClusterCleanupDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name_patterns": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+				Description: "Regular expressions matched against cluster names (e.g., '^pr-[0-9]+$'). A cluster matching any of them is a candidate for deletion",
+			},
+			"keep_clusters": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of clusters that are never deleted, such as the environments of open pull requests",
+			},
+			"min_age": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				Description:  "Only delete clusters created at least this long ago, as a Go duration (e.g., '72h'). Clusters without a known creation time are kept",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only compute candidate_clusters without deleting anything (default: false)",
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 20),
+				Description:  "Number of clusters deleted concurrently (default: 4)",
+			},
+			"candidate_clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Cluster names the cleanup deletes, computed during plan. Apply deletes exactly this list",
+			},
+			"deleted_clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Cluster names that were successfully deleted",
+			},
+			"failed_clusters": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Cluster names that could not be deleted, mapped to the error. Failed clusters are retried on the next apply",
+			},
+		},
+	}
+}
+
+// resourceClusterCleanupDiff computes candidate_clusters during plan so the clusters a cleanup
+// deletes can be reviewed before apply. A changed candidate list re-runs the cleanup.
+func resourceClusterCleanupDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	// Retry the clusters the last run failed to delete.
+	if d.Id() != "" && len(d.Get("failed_clusters").(map[string]interface{})) > 0 {
+		if err := d.SetNewComputed("failed_clusters"); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("name_patterns") || !d.NewValueKnown("keep_clusters") || !d.NewValueKnown("min_age") {
+		return d.SetNewComputed("candidate_clusters")
+	}
+
+	candidates, err := clusterCleanupCandidates(ctx, client, d.Get("name_patterns").([]interface{}),
+		d.Get("keep_clusters").(*schema.Set).List(), d.Get("min_age").(string))
+	if err != nil {
+		log.Printf("[WARN] could not compute cluster cleanup candidates during plan: %v", err)
+		return d.SetNewComputed("candidate_clusters")
+	}
+	return d.SetNew("candidate_clusters", candidates)
+}
+
+// clusterCleanupCandidates lists all clusters and returns the names matching any of patterns
+// that are not in keep and, if minAge is set, were created at least minAge ago.
+func clusterCleanupCandidates(ctx context.Context, client *apiClient, patterns, keep []interface{}, minAge string) ([]string, error) {
+	var nameRegexes []*regexp.Regexp
+	for _, p := range patterns {
+		re, err := regexp.Compile(p.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid name pattern %q: %w", p.(string), err)
+		}
+		nameRegexes = append(nameRegexes, re)
+	}
+
+	keepClusters := make(map[string]bool)
+	for _, k := range keep {
+		keepClusters[k.(string)] = true
+	}
+
+	var age time.Duration
+	if minAge != "" {
+		var err error
+		if age, err = time.ParseDuration(minAge); err != nil {
+			return nil, fmt.Errorf("invalid min_age: %w", err)
+		}
+	}
+
+	clusters, err := fetchAllClusters(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	candidates := []string{}
+	for _, c := range clusters {
+		if keepClusters[c.Name] {
+			continue
+		}
+		matched := false
+		for _, re := range nameRegexes {
+			if re.MatchString(c.Name) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if age > 0 {
+			created, err := time.Parse(time.RFC3339, c.CreationTimestamp)
+			if err != nil || time.Since(created) < age {
+				continue
+			}
+		}
+		candidates = append(candidates, c.Name)
+	}
+	sort.Strings(candidates)
+
+	log.Printf("[INFO] found %d stale clusters out of %d clusters", len(candidates), len(clusters))
+	return candidates, nil
+}
+
+// resourceClusterCleanupCreate deletes the stale clusters shown in the plan
+func resourceClusterCleanupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	// Delete exactly what the plan showed; only compute the candidates now if the plan could not.
+	var clustersToDelete []string
+	if d.GetRawPlan().GetAttr("candidate_clusters").IsKnown() {
+		for _, name := range d.Get("candidate_clusters").([]interface{}) {
+			clustersToDelete = append(clustersToDelete, name.(string))
+		}
+	} else {
+		candidates, err := clusterCleanupCandidates(ctx, client, d.Get("name_patterns").([]interface{}),
+			d.Get("keep_clusters").(*schema.Set).List(), d.Get("min_age").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		clustersToDelete = candidates
+	}
+
+	d.SetId("cluster-cleanup")
+	_ = d.Set("candidate_clusters", clustersToDelete)
+
+	if d.Get("dry_run").(bool) || len(clustersToDelete) == 0 {
+		log.Printf("[INFO] cluster cleanup: %d stale clusters, dry_run=%t: %v", len(clustersToDelete), d.Get("dry_run").(bool), clustersToDelete)
+		_ = d.Set("deleted_clusters", []string{})
+		_ = d.Set("failed_clusters", map[string]string{})
+		return nil
+	}
+
+	deleted, failed := deleteInParallel(ctx, clustersToDelete, d.Get("parallelism").(int), func(ctx context.Context, name string) error {
+		return deleteStaleCluster(ctx, client, name)
+	})
+	_ = d.Set("deleted_clusters", deleted)
+	_ = d.Set("failed_clusters", failed)
+
+	if len(failed) > 0 {
+		msgs := make([]string, 0, len(failed))
+		for name, msg := range failed {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", name, msg))
+		}
+		sort.Strings(msgs)
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to delete %d of %d stale clusters", len(failed), len(clustersToDelete)),
+			Detail:   strings.Join(msgs, "\n"),
+		}}
+	}
+
+	log.Printf("[INFO] cluster cleanup completed: deleted %d clusters", len(deleted))
+	return nil
+}
+
+// resourceClusterCleanupRead is a no-op; the cleanup has no server-side state.
+func resourceClusterCleanupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+// resourceClusterCleanupUpdate re-runs the cleanup when its inputs, the candidates or earlier failures change.
+func resourceClusterCleanupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("name_patterns", "keep_clusters", "min_age", "dry_run", "candidate_clusters", "failed_clusters") {
+		return resourceClusterCleanupCreate(ctx, d, m)
+	}
+	return resourceClusterCleanupRead(ctx, d, m)
+}
+
+// resourceClusterCleanupDelete only removes the resource from state; deleted clusters are not restored.
+func resourceClusterCleanupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// deleteStaleCluster calls DELETE /deletecluster?Name=<name>&Namespace=<namespace> for a cluster
+// found by the cleanup. Clusters stamped by another configuration are refused when
+// require_managed_by is set.
+func deleteStaleCluster(ctx context.Context, client *apiClient, name string) error {
+	info, err := fetchClusterInfo(ctx, client, name)
+	if err != nil {
+		return fmt.Errorf("failed to fetch cluster info: %w", err)
+	}
+	if info == nil {
+		log.Printf("[INFO] cluster %s not found (already deleted)", name)
+		return nil
+	}
+	if diags := client.verifyManagedBy("cluster", name, info.Labels[managedByKey]); diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	u := fmt.Sprintf("%s/deletecluster?Name=%s", client.BaseURL, url.QueryEscape(name))
+	if info.NameSpace != "" {
+		u += fmt.Sprintf("&Namespace=%s", url.QueryEscape(info.NameSpace))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if client.Token != "" {
+		req.Header.Set("Authorization", client.Token)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return fmt.Errorf("deletecluster request failed: %v", diags)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("deletecluster failed: %s: %s", resp.Status, string(bodyBytes))
+	}
+
+	log.Printf("[INFO] deleted stale cluster %s (namespace: %s)", name, info.NameSpace)
+	return nil
+}
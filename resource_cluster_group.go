@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ClusterGroupPayload represents the JSON body sent to create/update cluster groups.
+type ClusterGroupPayload struct {
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	Clusters        []string `json:"clusters"`
+	AlertWebhookIDs []string `json:"alertWebhookIds"`
+}
+
+// ClusterGroupInfo represents the JSON structure returned from the cluster groups API.
+type ClusterGroupInfo struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	Clusters        []string `json:"clusters"`
+	AlertWebhookIDs []string `json:"alertWebhookIds"`
+	CreatedAt       string   `json:"createdAt"`
+}
+
+// resourceClusterGroup defines the bugx_cluster_group resource schema and CRUD.
+// A cluster group is a named set of clusters that fleet-wide operations, such as bulk
+// upgrades and alert routing, can target by group ID.
+func resourceClusterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterGroupCreate,
+		ReadContext:   resourceClusterGroupRead,
+		UpdateContext: resourceClusterGroupUpdate,
+		DeleteContext: resourceClusterGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the group (must be unique)",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Free-form description of the group",
+			},
+			"clusters": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the bugx clusters in the group",
+			},
+			"alert_webhook_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of bugx_webhook resources that receive the alerts of every cluster in the group",
+			},
+			"cluster_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of clusters in the group",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the group was created",
+			},
+		},
+	}
+}
+
+// buildClusterGroupPayload converts Terraform state to API payload.
+func buildClusterGroupPayload(d *schema.ResourceData) ClusterGroupPayload {
+	payload := ClusterGroupPayload{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		Clusters:        []string{},
+		AlertWebhookIDs: []string{},
+	}
+
+	if clusters, ok := d.Get("clusters").(*schema.Set); ok {
+		for _, c := range clusters.List() {
+			payload.Clusters = append(payload.Clusters, c.(string))
+		}
+	}
+	if hooks, ok := d.Get("alert_webhook_ids").(*schema.Set); ok {
+		for _, h := range hooks.List() {
+			payload.AlertWebhookIDs = append(payload.AlertWebhookIDs, h.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceClusterGroupCreate calls POST /fleet/api/v1/groups.
+func resourceClusterGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildClusterGroupPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/fleet/api/v1/groups", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create cluster group failed: %s: %s", resp.Status, string(b))
+	}
+
+	var group ClusterGroupInfo
+	if err := decodeAPIResponse(resp, &group); err != nil {
+		return diag.Errorf("failed to decode create cluster group response: %v", err)
+	}
+	if group.ID == "" {
+		return diag.Errorf("create cluster group succeeded but no id returned")
+	}
+
+	d.SetId(group.ID)
+	log.Printf("[INFO] created cluster group %s", group.ID)
+	return resourceClusterGroupRead(ctx, d, m)
+}
+
+// resourceClusterGroupRead calls GET /fleet/api/v1/groups/:id.
+func resourceClusterGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	group, err := fetchClusterGroupByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if group == nil {
+		// Cluster group not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", group.Name)
+	_ = d.Set("description", group.Description)
+	_ = d.Set("clusters", group.Clusters)
+	_ = d.Set("alert_webhook_ids", group.AlertWebhookIDs)
+	_ = d.Set("cluster_count", len(group.Clusters))
+	_ = d.Set("created_at", group.CreatedAt)
+
+	return nil
+}
+
+// resourceClusterGroupUpdate calls PUT /fleet/api/v1/groups/:id.
+func resourceClusterGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildClusterGroupPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/fleet/api/v1/groups/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update cluster group failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceClusterGroupRead(ctx, d, m)
+}
+
+// resourceClusterGroupDelete calls DELETE /fleet/api/v1/groups/:id.
+func resourceClusterGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/fleet/api/v1/groups/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] cluster group %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete cluster group failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted cluster group %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchClusterGroupByID queries GET /fleet/api/v1/groups/:id and returns the cluster group.
+func fetchClusterGroupByID(ctx context.Context, client *apiClient, id string) (*ClusterGroupInfo, error) {
+	u := fmt.Sprintf("%s/fleet/api/v1/groups/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("cluster group fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var group ClusterGroupInfo
+	if err := decodeAPIResponse(resp, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
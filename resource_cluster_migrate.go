@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceClusterV0 is the bugx_cluster schema as it was before SchemaVersion was introduced.
+// It is only used to decode version 0 states for resourceClusterStateUpgradeV0.
+func resourceClusterV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":                  {Type: schema.TypeString, Required: true},
+			"cluster_id":            {Type: schema.TypeString, Optional: true, Computed: true},
+			"control_plane":         {Type: schema.TypeString, Required: true},
+			"status":                {Type: schema.TypeString, Optional: true, Default: "Progressing"},
+			"cpu":                   {Type: schema.TypeString, Required: true},
+			"memory":                {Type: schema.TypeString, Required: true},
+			"platform_version":      {Type: schema.TypeString, Required: true},
+			"health_check":          {Type: schema.TypeString, Optional: true},
+			"alert":                 {Type: schema.TypeString, Optional: true},
+			"endpoint":              {Type: schema.TypeString, Optional: true, Computed: true},
+			"namespace":             {Type: schema.TypeString, Optional: true, Computed: true},
+			"kubeconfig":            {Type: schema.TypeString, Optional: true, Computed: true, Sensitive: true},
+			"cluster_type":          {Type: schema.TypeString, Required: true},
+			"coredns_cpu":           {Type: schema.TypeString, Required: true},
+			"coredns_memory":        {Type: schema.TypeString, Required: true},
+			"apiserver_cpu":         {Type: schema.TypeString, Required: true},
+			"apiserver_memory":      {Type: schema.TypeString, Required: true},
+			"extra_values":          {Type: schema.TypeString, Optional: true},
+			"isolation_mode":        {Type: schema.TypeString, Optional: true, Computed: true},
+			"pod_security_standard": {Type: schema.TypeString, Optional: true, Computed: true},
+		},
+	}
+}
+
+// resourceClusterStateUpgradeV0 migrates a version 0 bugx_cluster state to version 1.
+// Version 0 states created before the API returned a ClusterID may carry an empty
+// cluster_id even though the resource ID holds it; backfill it so later versions can rely on it.
+func resourceClusterStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	id, _ := rawState["id"].(string)
+	clusterID, _ := rawState["cluster_id"].(string)
+	if clusterID == "" && id != "" {
+		log.Printf("[INFO] upgrading bugx_cluster state v0 -> v1: setting cluster_id to %s", id)
+		rawState["cluster_id"] = id
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ConfigMapPayload represents the JSON body sent to create/update config maps.
+type ConfigMapPayload struct {
+	ClusterName string            `json:"clusterName"`
+	Namespace   string            `json:"namespace"`
+	Name        string            `json:"name"`
+	Data        map[string]string `json:"data"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// ConfigMapInfo represents the JSON structure returned from the config maps API.
+type ConfigMapInfo struct {
+	ID          string            `json:"id"`
+	ClusterName string            `json:"clusterName"`
+	Namespace   string            `json:"namespace"`
+	Name        string            `json:"name"`
+	Data        map[string]string `json:"data"`
+	Labels      map[string]string `json:"labels"`
+	CreatedAt   string            `json:"createdAt"`
+	UpdatedAt   string            `json:"updatedAt"`
+}
+
+// resourceConfigMap defines the bugx_configmap resource schema and CRUD.
+// Config maps hold non-sensitive configuration inside a cluster; use bugx_secret for credentials.
+func resourceConfigMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConfigMapCreate,
+		ReadContext:   resourceConfigMapRead,
+		UpdateContext: resourceConfigMapUpdate,
+		DeleteContext: resourceConfigMapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the config map lives in",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace of the config map (default: default)",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the config map",
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of key-value pairs stored in the config map",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kubernetes labels attached to the config map",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the config map was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the config map was last updated",
+			},
+		},
+	}
+}
+
+// buildConfigMapPayload converts Terraform state to API payload.
+func buildConfigMapPayload(d *schema.ResourceData) ConfigMapPayload {
+	payload := ConfigMapPayload{
+		ClusterName: d.Get("cluster_name").(string),
+		Namespace:   d.Get("namespace").(string),
+		Name:        d.Get("name").(string),
+		Data:        expandStringMap(d.Get("data").(map[string]interface{})),
+		Labels:      expandStringMap(d.Get("labels").(map[string]interface{})),
+	}
+	if payload.Data == nil {
+		payload.Data = map[string]string{}
+	}
+	return payload
+}
+
+// resourceConfigMapCreate calls POST /configs/api/v1/configmaps.
+func resourceConfigMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildConfigMapPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/configs/api/v1/configmaps", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create config map failed: %s: %s", resp.Status, string(b))
+	}
+
+	var cm ConfigMapInfo
+	if err := decodeAPIResponse(resp, &cm); err != nil {
+		return diag.Errorf("failed to decode create config map response: %v", err)
+	}
+	if cm.ID == "" {
+		return diag.Errorf("create config map succeeded but no id returned")
+	}
+
+	d.SetId(cm.ID)
+	log.Printf("[INFO] created config map %s", cm.ID)
+	return resourceConfigMapRead(ctx, d, m)
+}
+
+// resourceConfigMapRead calls GET /configs/api/v1/configmaps/:id.
+func resourceConfigMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	cm, err := fetchConfigMapByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cm == nil {
+		// Config map not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", cm.ClusterName)
+	_ = d.Set("namespace", cm.Namespace)
+	_ = d.Set("name", cm.Name)
+	_ = d.Set("data", cm.Data)
+	_ = d.Set("labels", cm.Labels)
+	_ = d.Set("created_at", cm.CreatedAt)
+	_ = d.Set("updated_at", cm.UpdatedAt)
+
+	return nil
+}
+
+// resourceConfigMapUpdate calls PUT /configs/api/v1/configmaps/:id.
+func resourceConfigMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildConfigMapPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/configs/api/v1/configmaps/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update config map failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceConfigMapRead(ctx, d, m)
+}
+
+// resourceConfigMapDelete calls DELETE /configs/api/v1/configmaps/:id.
+func resourceConfigMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/configs/api/v1/configmaps/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] config map %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete config map failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted config map %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchConfigMapByID queries GET /configs/api/v1/configmaps/:id and returns the config map.
+func fetchConfigMapByID(ctx context.Context, client *apiClient, id string) (*ConfigMapInfo, error) {
+	u := fmt.Sprintf("%s/configs/api/v1/configmaps/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("config map fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var cm ConfigMapInfo
+	if err := decodeAPIResponse(resp, &cm); err != nil {
+		return nil, err
+	}
+	return &cm, nil
+}
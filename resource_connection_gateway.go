@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// GatewayPayload represents the JSON body sent to create/update connection gateways.
+type GatewayPayload struct {
+	Name            string   `json:"name"`
+	ClusterName     string   `json:"clusterName"`
+	ListenerPort    int      `json:"listenerPort"`
+	AllowedNetworks []string `json:"allowedNetworks"`
+	IdleTimeout     int      `json:"idleTimeout"`
+}
+
+// GatewayInfo represents the JSON structure returned from the gateways API.
+type GatewayInfo struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	ClusterName      string   `json:"clusterName"`
+	ListenerPort     int      `json:"listenerPort"`
+	AllowedNetworks  []string `json:"allowedNetworks"`
+	IdleTimeout      int      `json:"idleTimeout"`
+	ConnectionString string   `json:"connectionString"`
+}
+
+// resourceConnectionGateway defines the bugx_connection_gateway resource schema and CRUD.
+func resourceConnectionGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConnectionGatewayCreate,
+		ReadContext:   resourceConnectionGatewayRead,
+		UpdateContext: resourceConnectionGatewayUpdate,
+		DeleteContext: resourceConnectionGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the connection gateway",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the gateway exposes",
+			},
+			"listener_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port the gateway listens on at the platform edge",
+			},
+			"allowed_networks": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+				Description: "CIDR ranges allowed to connect through the gateway. If empty, the platform default applies",
+			},
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Idle connection timeout in seconds (default: 300)",
+			},
+			"connection_string": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public connection string for reaching the cluster through the gateway",
+			},
+		},
+	}
+}
+
+// buildGatewayPayload converts Terraform state to API payload.
+func buildGatewayPayload(d *schema.ResourceData) GatewayPayload {
+	payload := GatewayPayload{
+		Name:            d.Get("name").(string),
+		ClusterName:     d.Get("cluster_name").(string),
+		ListenerPort:    d.Get("listener_port").(int),
+		AllowedNetworks: []string{},
+		IdleTimeout:     d.Get("idle_timeout").(int),
+	}
+
+	if networks, ok := d.Get("allowed_networks").(*schema.Set); ok {
+		for _, n := range networks.List() {
+			payload.AllowedNetworks = append(payload.AllowedNetworks, n.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceConnectionGatewayCreate calls POST /gateways/api/v1/gateways.
+func resourceConnectionGatewayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildGatewayPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/gateways/api/v1/gateways", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create connection gateway failed: %s: %s", resp.Status, string(b))
+	}
+
+	var gateway GatewayInfo
+	if err := decodeAPIResponse(resp, &gateway); err != nil {
+		return diag.Errorf("failed to decode create connection gateway response: %v", err)
+	}
+	if gateway.ID == "" {
+		return diag.Errorf("create connection gateway succeeded but no id returned")
+	}
+
+	d.SetId(gateway.ID)
+	log.Printf("[INFO] created connection gateway %s for cluster %s", gateway.ID, payload.ClusterName)
+	return resourceConnectionGatewayRead(ctx, d, m)
+}
+
+// resourceConnectionGatewayRead calls GET /gateways/api/v1/gateways/:id.
+func resourceConnectionGatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	gateway, err := fetchGatewayByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if gateway == nil {
+		// Gateway not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", gateway.Name)
+	_ = d.Set("cluster_name", gateway.ClusterName)
+	_ = d.Set("listener_port", gateway.ListenerPort)
+	_ = d.Set("allowed_networks", gateway.AllowedNetworks)
+	_ = d.Set("idle_timeout", gateway.IdleTimeout)
+	_ = d.Set("connection_string", gateway.ConnectionString)
+
+	return nil
+}
+
+// resourceConnectionGatewayUpdate calls PUT /gateways/api/v1/gateways/:id.
+func resourceConnectionGatewayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildGatewayPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/gateways/api/v1/gateways/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update connection gateway failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceConnectionGatewayRead(ctx, d, m)
+}
+
+// resourceConnectionGatewayDelete calls DELETE /gateways/api/v1/gateways/:id.
+func resourceConnectionGatewayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/gateways/api/v1/gateways/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] connection gateway %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete connection gateway failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted connection gateway %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchGatewayByID queries GET /gateways/api/v1/gateways/:id and returns the gateway.
+func fetchGatewayByID(ctx context.Context, client *apiClient, id string) (*GatewayInfo, error) {
+	u := fmt.Sprintf("%s/gateways/api/v1/gateways/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("connection gateway fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var gateway GatewayInfo
+	if err := decodeAPIResponse(resp, &gateway); err != nil {
+		return nil, err
+	}
+	return &gateway, nil
+}
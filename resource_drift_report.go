@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DriftReportPayload represents the JSON body sent to create/update drift scans.
+type DriftReportPayload struct {
+	ClusterName string `json:"clusterName"`
+	Schedule    string `json:"schedule"`
+	Manifest    string `json:"manifest"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// DriftReportInfo represents the JSON structure returned from the drift reports API.
+type DriftReportInfo struct {
+	ID            string   `json:"id"`
+	ClusterName   string   `json:"clusterName"`
+	Schedule      string   `json:"schedule"`
+	Manifest      string   `json:"manifest"`
+	Enabled       bool     `json:"enabled"`
+	LastScanAt    string   `json:"lastScanAt,omitempty"`
+	LastScanState string   `json:"lastScanStatus,omitempty"`
+	DriftDetected bool     `json:"driftDetected"`
+	DriftedItems  []string `json:"driftedItems,omitempty"`
+}
+
+// resourceDriftReport defines the bugx_drift_report resource schema and CRUD.
+// The platform periodically compares the cluster's live add-ons and releases against
+// the manifest uploaded on every apply and keeps the latest result.
+func resourceDriftReport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDriftReportCreate,
+		ReadContext:   resourceDriftReportRead,
+		UpdateContext: resourceDriftReportUpdate,
+		DeleteContext: resourceDriftReportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster to scan",
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0 * * * *",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Cron expression for how often the platform scans for drift (default: '0 * * * *', hourly)",
+			},
+			"manifest": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateYAML,
+				DiffSuppressFunc: suppressEquivalentYAMLDiff,
+				Description:      "Declared add-ons/releases snapshot (YAML or JSON) the platform compares the live cluster against",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether scheduled scanning is active (default: true)",
+			},
+			"last_scan_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the latest drift scan",
+			},
+			"last_scan_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Outcome of the latest drift scan (e.g., 'succeeded', 'failed')",
+			},
+			"drift_detected": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the latest scan found differences from the manifest",
+			},
+			"drifted_items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Add-ons or releases that differ from the manifest in the latest scan",
+			},
+		},
+	}
+}
+
+// buildDriftReportPayload converts Terraform state to API payload.
+func buildDriftReportPayload(d *schema.ResourceData) DriftReportPayload {
+	return DriftReportPayload{
+		ClusterName: d.Get("cluster_name").(string),
+		Schedule:    d.Get("schedule").(string),
+		Manifest:    d.Get("manifest").(string),
+		Enabled:     d.Get("enabled").(bool),
+	}
+}
+
+// resourceDriftReportCreate calls POST /drift/api/v1/reports.
+func resourceDriftReportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildDriftReportPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/drift/api/v1/reports", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create drift report failed: %s: %s", resp.Status, string(b))
+	}
+
+	var report DriftReportInfo
+	if err := decodeAPIResponse(resp, &report); err != nil {
+		return diag.Errorf("failed to decode create drift report response: %v", err)
+	}
+	if report.ID == "" {
+		return diag.Errorf("create drift report succeeded but no id returned")
+	}
+
+	d.SetId(report.ID)
+	log.Printf("[INFO] created drift report %s", report.ID)
+	return resourceDriftReportRead(ctx, d, m)
+}
+
+// resourceDriftReportRead calls GET /drift/api/v1/reports/:id.
+func resourceDriftReportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	report, err := fetchDriftReportByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if report == nil {
+		// Drift report not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", report.ClusterName)
+	_ = d.Set("schedule", report.Schedule)
+	_ = d.Set("manifest", report.Manifest)
+	_ = d.Set("enabled", report.Enabled)
+	_ = d.Set("last_scan_at", report.LastScanAt)
+	_ = d.Set("last_scan_status", report.LastScanState)
+	_ = d.Set("drift_detected", report.DriftDetected)
+	_ = d.Set("drifted_items", report.DriftedItems)
+
+	return nil
+}
+
+// resourceDriftReportUpdate calls PUT /drift/api/v1/reports/:id.
+func resourceDriftReportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildDriftReportPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/drift/api/v1/reports/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update drift report failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceDriftReportRead(ctx, d, m)
+}
+
+// resourceDriftReportDelete calls DELETE /drift/api/v1/reports/:id.
+func resourceDriftReportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/drift/api/v1/reports/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] drift report %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete drift report failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted drift report %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchDriftReportByID queries GET /drift/api/v1/reports/:id and returns the drift report.
+func fetchDriftReportByID(ctx context.Context, client *apiClient, id string) (*DriftReportInfo, error) {
+	u := fmt.Sprintf("%s/drift/api/v1/reports/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("drift report fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var report DriftReportInfo
+	if err := decodeAPIResponse(resp, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
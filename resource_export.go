@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceExport defines a resource that exports a cluster's declarative definition
+// from the platform and optionally writes it to a local file.
+func resourceExport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceExportCreate,
+		ReadContext:   resourceExportRead,
+		DeleteContext: resourceExportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster to export",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "yaml",
+				ValidateFunc: validation.StringInSlice([]string{"yaml", "json"}, false),
+				Description:  "Export format: 'yaml' or 'json' (default: 'yaml')",
+			},
+			"filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Path of a local file to write the export to. If omitted, the export is only available through 'content'",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Exported cluster definition",
+			},
+			"content_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the exported definition",
+			},
+		},
+	}
+}
+
+// resourceExportCreate calls GET /export and writes the result to filename if set.
+func resourceExportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	clusterName := d.Get("cluster_name").(string)
+	format := d.Get("format").(string)
+
+	content, err := fetchClusterExport(ctx, client, clusterName, format)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if filename := d.Get("filename").(string); filename != "" {
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			return diag.Errorf("failed to write export file %s: %v", filename, err)
+		}
+		log.Printf("[INFO] wrote export of cluster %s to %s", clusterName, filename)
+	}
+
+	_ = d.Set("content", content)
+	_ = d.Set("content_sha256", exportChecksum(content))
+	d.SetId(fmt.Sprintf("%s-export-%s", clusterName, format))
+	return nil
+}
+
+// resourceExportRead re-fetches the export. If the platform definition changed or the
+// local file is gone, the resource is removed from state so the next apply rewrites it.
+func resourceExportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	clusterName := d.Get("cluster_name").(string)
+	format := d.Get("format").(string)
+
+	content, err := fetchClusterExport(ctx, client, clusterName, format)
+	if err != nil {
+		return diag.Errorf("failed to refresh export of cluster %s: %v", clusterName, err)
+	}
+	if content == "" {
+		// Cluster no longer exists; nothing to export.
+		d.SetId("")
+		return nil
+	}
+
+	if exportChecksum(content) != d.Get("content_sha256").(string) {
+		log.Printf("[INFO] export of cluster %s changed on the platform, it will be regenerated", clusterName)
+		d.SetId("")
+		return nil
+	}
+
+	if filename := d.Get("filename").(string); filename != "" {
+		existing, err := os.ReadFile(filename)
+		if err != nil || exportChecksum(string(existing)) != d.Get("content_sha256").(string) {
+			log.Printf("[INFO] export file %s is missing or modified, it will be rewritten", filename)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// resourceExportDelete removes the local export file, if any.
+func resourceExportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if filename := d.Get("filename").(string); filename != "" {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return diag.Errorf("failed to remove export file %s: %v", filename, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// fetchClusterExport queries /export?Name=<name>&Format=<format> and returns the exported definition.
+// An empty string is returned if the cluster does not exist.
+func fetchClusterExport(ctx context.Context, client *apiClient, name, format string) (string, error) {
+	u := fmt.Sprintf("%s/export?Name=%s&Format=%s", client.BaseURL, url.QueryEscape(name), url.QueryEscape(format))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "*/*")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("cluster export failed: %s: %s", resp.Status, string(b))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read export response: %w", err)
+	}
+
+	return string(body), nil
+}
+
+// exportChecksum returns the hex-encoded SHA-256 of content.
+func exportChecksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// GitOpsSyncPolicy represents how Argo CD syncs a GitOps app.
+type GitOpsSyncPolicy struct {
+	Automated bool `json:"automated"`
+	Prune     bool `json:"prune"`
+	SelfHeal  bool `json:"selfHeal"`
+}
+
+// GitOpsAppPayload represents the JSON body sent to create/update GitOps apps.
+type GitOpsAppPayload struct {
+	Name         string           `json:"name"`
+	ClusterName  string           `json:"clusterName"`
+	RepoURL      string           `json:"repoUrl"`
+	Revision     string           `json:"revision"`
+	Path         string           `json:"path"`
+	Namespace    string           `json:"namespace"`
+	RepoSecretID string           `json:"repoSecretId,omitempty"`
+	SyncPolicy   GitOpsSyncPolicy `json:"syncPolicy"`
+}
+
+// GitOpsAppInfo represents the JSON structure returned from the GitOps applications API.
+type GitOpsAppInfo struct {
+	ID             string           `json:"id"`
+	Name           string           `json:"name"`
+	ClusterName    string           `json:"clusterName"`
+	RepoURL        string           `json:"repoUrl"`
+	Revision       string           `json:"revision"`
+	Path           string           `json:"path"`
+	Namespace      string           `json:"namespace"`
+	RepoSecretID   string           `json:"repoSecretId"`
+	SyncPolicy     GitOpsSyncPolicy `json:"syncPolicy"`
+	SyncStatus     string           `json:"syncStatus"`
+	HealthStatus   string           `json:"healthStatus"`
+	SyncedRevision string           `json:"syncedRevision"`
+}
+
+// resourceGitOpsApp defines the bugx_gitops_app resource schema and CRUD.
+// A GitOps app points the platform's Argo CD at a path in a Git repository and deploys it
+// into a cluster.
+func resourceGitOpsApp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitOpsAppCreate,
+		ReadContext:   resourceGitOpsAppRead,
+		UpdateContext: resourceGitOpsAppUpdate,
+		DeleteContext: resourceGitOpsAppDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the Argo CD application",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the application is deployed to",
+			},
+			"repo_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "URL of the Git repository (HTTPS or SSH)",
+			},
+			"revision": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "HEAD",
+				Description: "Branch, tag or commit to deploy (default: HEAD)",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     ".",
+				Description: "Directory inside the repository holding the manifests, Kustomization or Helm chart (default: .)",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace resources without one are deployed to (default: default)",
+			},
+			"repo_secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of a bugx_secret holding repository credentials ('username'/'password' or 'ssh_private_key'). Not needed for public repositories",
+			},
+			"sync_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "How Argo CD syncs the application. If omitted, it is synced manually",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automated": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Sync automatically when the repository changes (default: true)",
+						},
+						"prune": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Delete resources that were removed from the repository (default: false)",
+						},
+						"self_heal": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Revert changes made in the cluster outside of Git (default: false)",
+						},
+					},
+				},
+			},
+			"sync_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Argo CD sync status (e.g., 'Synced', 'OutOfSync')",
+			},
+			"health_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Argo CD health status (e.g., 'Healthy', 'Progressing', 'Degraded')",
+			},
+			"synced_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Commit last synced to the cluster",
+			},
+		},
+	}
+}
+
+// buildGitOpsAppPayload converts Terraform state to API payload.
+func buildGitOpsAppPayload(d *schema.ResourceData) GitOpsAppPayload {
+	payload := GitOpsAppPayload{
+		Name:         d.Get("name").(string),
+		ClusterName:  d.Get("cluster_name").(string),
+		RepoURL:      d.Get("repo_url").(string),
+		Revision:     d.Get("revision").(string),
+		Path:         d.Get("path").(string),
+		Namespace:    d.Get("namespace").(string),
+		RepoSecretID: d.Get("repo_secret_id").(string),
+	}
+
+	if blocks := d.Get("sync_policy").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		p := blocks[0].(map[string]interface{})
+		payload.SyncPolicy = GitOpsSyncPolicy{
+			Automated: p["automated"].(bool),
+			Prune:     p["prune"].(bool),
+			SelfHeal:  p["self_heal"].(bool),
+		}
+	}
+
+	return payload
+}
+
+// flattenGitOpsSyncPolicy converts an API sync policy to Terraform state. A manual
+// policy becomes no block.
+func flattenGitOpsSyncPolicy(p GitOpsSyncPolicy) []interface{} {
+	if p == (GitOpsSyncPolicy{}) {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"automated": p.Automated,
+		"prune":     p.Prune,
+		"self_heal": p.SelfHeal,
+	}}
+}
+
+// resourceGitOpsAppCreate calls POST /gitops/api/v1/applications.
+func resourceGitOpsAppCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildGitOpsAppPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/gitops/api/v1/applications", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create GitOps app failed: %s: %s", resp.Status, string(b))
+	}
+
+	var app GitOpsAppInfo
+	if err := decodeAPIResponse(resp, &app); err != nil {
+		return diag.Errorf("failed to decode create GitOps app response: %v", err)
+	}
+	if app.ID == "" {
+		return diag.Errorf("create GitOps app succeeded but no id returned")
+	}
+
+	d.SetId(app.ID)
+	log.Printf("[INFO] created GitOps app %s", app.ID)
+	return resourceGitOpsAppRead(ctx, d, m)
+}
+
+// resourceGitOpsAppRead calls GET /gitops/api/v1/applications/:id.
+func resourceGitOpsAppRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	app, err := fetchGitOpsAppByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if app == nil {
+		// GitOps app not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", app.Name)
+	_ = d.Set("cluster_name", app.ClusterName)
+	_ = d.Set("repo_url", app.RepoURL)
+	_ = d.Set("revision", app.Revision)
+	_ = d.Set("path", app.Path)
+	_ = d.Set("namespace", app.Namespace)
+	_ = d.Set("repo_secret_id", app.RepoSecretID)
+	_ = d.Set("sync_policy", flattenGitOpsSyncPolicy(app.SyncPolicy))
+	_ = d.Set("sync_status", app.SyncStatus)
+	_ = d.Set("health_status", app.HealthStatus)
+	_ = d.Set("synced_revision", app.SyncedRevision)
+
+	return nil
+}
+
+// resourceGitOpsAppUpdate calls PUT /gitops/api/v1/applications/:id.
+func resourceGitOpsAppUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildGitOpsAppPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/gitops/api/v1/applications/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update GitOps app failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceGitOpsAppRead(ctx, d, m)
+}
+
+// resourceGitOpsAppDelete calls DELETE /gitops/api/v1/applications/:id.
+func resourceGitOpsAppDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/gitops/api/v1/applications/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] GitOps app %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete GitOps app failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted GitOps app %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchGitOpsAppByID queries GET /gitops/api/v1/applications/:id and returns the GitOps app.
+func fetchGitOpsAppByID(ctx context.Context, client *apiClient, id string) (*GitOpsAppInfo, error) {
+	u := fmt.Sprintf("%s/gitops/api/v1/applications/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("GitOps app fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var app GitOpsAppInfo
+	if err := decodeAPIResponse(resp, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// GuestAccessLinkPayload represents the JSON body sent to create/update guest access links.
+type GuestAccessLinkPayload struct {
+	ClusterName        string   `json:"clusterName"`
+	Description        string   `json:"description,omitempty"`
+	ExpiresAt          string   `json:"expiresAt"`
+	Permissions        []string `json:"permissions"`
+	AllowedEmailDomain string   `json:"allowedEmailDomain,omitempty"`
+}
+
+// GuestAccessLinkInfo represents the JSON structure returned from the guest links API.
+type GuestAccessLinkInfo struct {
+	ID                 string   `json:"id"`
+	ClusterName        string   `json:"clusterName"`
+	Description        string   `json:"description"`
+	ExpiresAt          string   `json:"expiresAt"`
+	Permissions        []string `json:"permissions"`
+	AllowedEmailDomain string   `json:"allowedEmailDomain"`
+	URL                string   `json:"url,omitempty"`
+	CreatedBy          string   `json:"createdBy"`
+	Status             string   `json:"status"`
+}
+
+// resourceGuestAccessLink defines the bugx_guest_access_link resource schema and CRUD.
+// A guest access link grants time-limited access to a cluster's dashboard and API without a platform account.
+func resourceGuestAccessLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGuestAccessLinkCreate,
+		ReadContext:   resourceGuestAccessLinkRead,
+		UpdateContext: resourceGuestAccessLinkUpdate,
+		DeleteContext: resourceGuestAccessLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the link grants access to",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Free-form note shown in the platform console, e.g. who the link was issued to",
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+				Description:      "Time the link stops working, in RFC 3339 format (e.g., '2026-01-31T18:00:00Z'). Can be moved to extend or shorten access",
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"dashboard:read", "dashboard:write", "api:read", "api:write", "logs:read"}, false),
+				},
+				Description: "What the link grants: 'dashboard:read', 'dashboard:write', 'api:read', 'api:write' and/or 'logs:read'",
+			},
+			"allowed_email_domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(emailDomainPattern, "must be a domain name, e.g. 'example.com'"),
+				Description:  "Only guests who verify an email address in this domain can use the link. If empty, anyone with the URL can",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Generated link URL to hand to the guest",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User that created the link",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Link status reported by the API (e.g., 'active', 'expired')",
+			},
+		},
+	}
+}
+
+// emailDomainPattern loosely matches a DNS domain name such as example.com.
+var emailDomainPattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?\.)+[A-Za-z]{2,}$`)
+
+// suppressEquivalentRFC3339 ignores differences in how the same instant is written,
+// e.g. a time zone offset versus UTC, as the API normalizes timestamps.
+func suppressEquivalentRFC3339(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// buildGuestAccessLinkPayload converts Terraform state to API payload.
+func buildGuestAccessLinkPayload(d *schema.ResourceData) GuestAccessLinkPayload {
+	payload := GuestAccessLinkPayload{
+		ClusterName:        d.Get("cluster_name").(string),
+		Description:        d.Get("description").(string),
+		ExpiresAt:          d.Get("expires_at").(string),
+		AllowedEmailDomain: d.Get("allowed_email_domain").(string),
+	}
+
+	if permissions, ok := d.Get("permissions").(*schema.Set); ok {
+		for _, p := range permissions.List() {
+			payload.Permissions = append(payload.Permissions, p.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceGuestAccessLinkCreate calls POST /access/api/v1/guest-links.
+func resourceGuestAccessLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildGuestAccessLinkPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/access/api/v1/guest-links", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create guest access link failed: %s: %s", resp.Status, string(b))
+	}
+
+	var link GuestAccessLinkInfo
+	if err := decodeAPIResponse(resp, &link); err != nil {
+		return diag.Errorf("failed to decode create guest access link response: %v", err)
+	}
+	if link.ID == "" {
+		return diag.Errorf("create guest access link succeeded but no id returned")
+	}
+
+	d.SetId(link.ID)
+	if link.URL != "" {
+		_ = d.Set("url", link.URL)
+	}
+	log.Printf("[INFO] created guest access link %s", link.ID)
+	return resourceGuestAccessLinkRead(ctx, d, m)
+}
+
+// resourceGuestAccessLinkRead calls GET /access/api/v1/guest-links/:id.
+func resourceGuestAccessLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	link, err := fetchGuestAccessLinkByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if link == nil {
+		// Guest access link not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", link.ClusterName)
+	_ = d.Set("description", link.Description)
+	_ = d.Set("expires_at", link.ExpiresAt)
+	_ = d.Set("permissions", link.Permissions)
+	_ = d.Set("allowed_email_domain", link.AllowedEmailDomain)
+	_ = d.Set("created_by", link.CreatedBy)
+	_ = d.Set("status", link.Status)
+	// The API only returns the URL on creation; keep the stored value otherwise.
+	if link.URL != "" {
+		_ = d.Set("url", link.URL)
+	}
+
+	return nil
+}
+
+// resourceGuestAccessLinkUpdate calls PUT /access/api/v1/guest-links/:id.
+func resourceGuestAccessLinkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildGuestAccessLinkPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/access/api/v1/guest-links/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update guest access link failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceGuestAccessLinkRead(ctx, d, m)
+}
+
+// resourceGuestAccessLinkDelete calls DELETE /access/api/v1/guest-links/:id.
+func resourceGuestAccessLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/access/api/v1/guest-links/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] guest access link %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete guest access link failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted guest access link %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchGuestAccessLinkByID queries GET /access/api/v1/guest-links/:id and returns the guest access link.
+func fetchGuestAccessLinkByID(ctx context.Context, client *apiClient, id string) (*GuestAccessLinkInfo, error) {
+	u := fmt.Sprintf("%s/access/api/v1/guest-links/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("guest access link fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var link GuestAccessLinkInfo
+	if err := decodeAPIResponse(resp, &link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
@@ -9,10 +9,13 @@ import (
 	"log"
 	"net/http"
 	"net/url"
-	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // HelmInstallPayload represents the JSON body sent to /helm_install.
@@ -21,9 +24,99 @@ type HelmInstallPayload struct {
 	Namespace   string `json:"Namespace"`
 	Release     string `json:"Release"`
 	Chart       string `json:"Chart"`
-	Values      string `json:"Values,omitempty"` // Optional: Helm values as YAML string
+	Repo        string `json:"Repo,omitempty"`
+	Version     string `json:"Version,omitempty"` // Optional: chart version, latest if empty
+	Values      string `json:"Values,omitempty"`  // Optional: Helm values as YAML string
+
+	Labels          map[string]string `json:"Labels,omitempty"`          // Optional: labels checked against label policies
+	CreateNamespace bool              `json:"CreateNamespace,omitempty"` // Optional: create Namespace if it does not exist
+	SkipCrds        bool              `json:"SkipCrds,omitempty"`        // Optional: do not install the chart's CRDs
+
+	ServiceAccount *HelmServiceAccount `json:"ServiceAccount,omitempty"` // Optional: release-scoped service account
+	Canary         *HelmCanary         `json:"Canary,omitempty"`         // Optional: progressive delivery settings
+
+	// Extra holds extra_payload fields merged into the request body by marshalHelmPayload.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// HelmRollbackPayload represents the JSON body sent to /helm_rollback.
+type HelmRollbackPayload struct {
+	Clustername string `json:"Clustername"`
+	Namespace   string `json:"Namespace"`
+	Release     string `json:"Release"`
+	Revision    int    `json:"Revision,omitempty"` // Optional: previous revision if zero
+}
+
+// HelmServiceAccount asks the platform to create a service account for the release
+// bound to the given RBAC rules instead of the default cluster-admin binding.
+type HelmServiceAccount struct {
+	Name          string         `json:"Name,omitempty"`
+	ClusterScoped bool           `json:"ClusterScoped"`
+	Rules         []HelmRBACRule `json:"Rules"`
+}
+
+// HelmCanary configures the platform's progressive delivery for upgrades of a release.
+type HelmCanary struct {
+	Steps            []int  `json:"Steps"`            // Traffic weights (percent) shifted to the new revision, in order
+	AnalysisInterval string `json:"AnalysisInterval"` // Time between analysis runs before the next step
+	AutoPromote      bool   `json:"AutoPromote"`      // Promote automatically after the last step passes
+}
+
+// HelmRBACRule mirrors a Kubernetes RBAC PolicyRule.
+type HelmRBACRule struct {
+	APIGroups     []string `json:"ApiGroups"`
+	Resources     []string `json:"Resources"`
+	Verbs         []string `json:"Verbs"`
+	ResourceNames []string `json:"ResourceNames,omitempty"`
+}
+
+// HelmReleaseInfo represents a release entry returned from /helm_releases.
+type HelmReleaseInfo struct {
+	Release      string `json:"Release"`
+	Namespace    string `json:"Namespace"`
+	Chart        string `json:"Chart"`
+	ChartVersion string `json:"ChartVersion"`
+	Values       string `json:"Values"`
+	Status       string `json:"Status"`
+	Ready        *bool  `json:"Ready,omitempty"` // All release pods are ready; nil if the API does not report it
+	Revision     int    `json:"Revision"`
+	AppVersion   string `json:"AppVersion"`
+
+	ServiceAccount string `json:"ServiceAccount,omitempty"`
+}
+
+// AppInfo represents an app record returned from /apps. Every Helm release installed
+// through the platform is backed by one app, which /deleteapp removes by Name.
+type AppInfo struct {
+	Name        string `json:"Name"`
+	Clustername string `json:"Clustername"`
+	Release     string `json:"Release"`
+	Namespace   string `json:"Namespace"` // Kubernetes namespace of the release
+	Chart       string `json:"Chart"`
+	Status      string `json:"Status"`
+}
+
+// HelmWorkloadStatus represents the JSON structure returned from /workload_status.
+type HelmWorkloadStatus struct {
+	Kind          string `json:"Kind"`
+	Namespace     string `json:"Namespace"`
+	Name          string `json:"Name"`
+	Replicas      int    `json:"Replicas"`
+	ReadyReplicas int    `json:"ReadyReplicas"`
+	Ready         bool   `json:"Ready"`
+}
+
+// helmReadinessCheck is a workload that must be ready before an install or upgrade succeeds.
+type helmReadinessCheck struct {
+	Kind      string
+	Namespace string
+	Name      string
 }
 
+// helmRedactedValues is recorded instead of the live values on drift when they may hold
+// set_sensitive values, so the correcting plan does not reveal them.
+const helmRedactedValues = "# live values differ from configuration (redacted, release uses set_sensitive)\n"
+
 // resourceHelmRelease defines the bugx_helm_release resource schema and CRUD.
 func resourceHelmRelease() *schema.Resource {
 	return &schema.Resource{
@@ -31,48 +124,355 @@ func resourceHelmRelease() *schema.Resource {
 		ReadContext:   resourceHelmReleaseRead,
 		UpdateContext: resourceHelmReleaseUpdate,
 		DeleteContext: resourceHelmReleaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHelmReleaseImport,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			resourceHelmReleaseCanaryDiff,
+			resourceHelmReleaseValuesTemplateDiff,
+			labelPolicyDiff("helm_release"),
+		),
+
+		// Bump SchemaVersion and append a StateUpgrader (see resource_helm_release_migrate.go)
+		// whenever an attribute is renamed or changes type.
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceHelmReleaseV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceHelmReleaseStateUpgradeV0,
+				Version: 0,
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Name of the bugx cluster where to deploy the Helm release",
 			},
 			"namespace": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Kubernetes namespace where to deploy the release",
 			},
 			"release": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Name of the Helm release",
 			},
 			"chart": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Chart name (e.g., 'bitnami/mysql' or 'mysql')",
 			},
 			"repo": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Helm repository URL (e.g., 'https://charts.bitnami.com/bitnami'). Optional if chart is already in the cluster's Helm repositories",
 			},
 			"values": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateYAML},
+				Description: "List of Helm values documents as YAML strings, merged in order (later entries override earlier ones). Use file() or templatefile() to load from a file",
+			},
+			"values_template_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Helm values as YAML string. You can use file() or templatefile() to load from a file",
+				Description: "Path to a values file rendered as a Go template with template_vars before it is sent. The rendered document is the base layer that values entries override",
 			},
-			"values_file": {
+			"template_vars": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"values_template_file"},
+				Description:  "Variables available to values_template_file, e.g. {{ .replicas }}",
+			},
+			"values_template_sha256": {
 				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of the rendered values_template_file, so edits to the file or template_vars plan an upgrade",
+			},
+			"set": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Values merged into the YAML sent to the API, applied in order after values",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Dotted path of the value to set (e.g., 'auth.rootPassword'). Escape literal dots with '\\.'",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value to set",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "auto",
+							ValidateFunc: validation.StringInSlice([]string{"auto", "string"}, false),
+							Description:  "'auto' parses the value as YAML (numbers, booleans), 'string' keeps it verbatim (default: 'auto')",
+						},
+					},
+				},
+			},
+			"set_sensitive": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Path to a Helm values YAML file. Alternative to 'values' attribute",
+				Description: "Like set, but the value is sensitive and kept out of plan output",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Dotted path of the value to set (e.g., 'auth.rootPassword'). Escape literal dots with '\\.'",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Value to set",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "auto",
+							ValidateFunc: validation.StringInSlice([]string{"auto", "string"}, false),
+							Description:  "'auto' parses the value as YAML (numbers, booleans), 'string' keeps it verbatim (default: 'auto')",
+						},
+					},
+				},
 			},
 			"chart_version": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Version of the Helm chart to install (e.g., '8.0.0'). If not specified, the latest version is used",
 			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels attached to the release, e.g. for label policies",
+			},
+			"create_namespace": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create the namespace if it does not exist (default: false)",
+			},
+			"skip_crds": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip installing the CRDs shipped in the chart's crds/ directory, e.g. when they are shared and managed separately (default: false)",
+			},
+			"extra_payload": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "JSON object merged into the helm_install/helm_upgrade request body, for backend fields the provider does not model yet. Use jsonencode()",
+			},
+			"uninstall_keep_history": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the release history when the release is uninstalled (default: false)",
+			},
+			"uninstall_no_hooks": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the chart's pre/post-delete hooks when the release is uninstalled (default: false)",
+			},
+			"uninstall_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Seconds the backend may spend uninstalling the release, including hooks (default: 300)",
+			},
+			"rollback_on_failure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Roll the release back to its previous revision when an upgrade request fails or, with wait, the release does not become ready (default: false)",
+			},
+			"wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until the release is deployed and its pods are ready before marking the install or upgrade successful (default: false)",
+			},
+			"atomic": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Uninstall a failed install, or roll back a failed upgrade, when the release does not become ready. Implies wait (default: false)",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Seconds to wait for the release, and any readiness_check workloads, to become ready (default: 300)",
+			},
+			"readiness_check": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Workloads that must report Ready after an install or upgrade before it is considered successful, polled through the backend",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Deployment", "StatefulSet"}, false),
+							Description:  "Workload kind: 'Deployment' or 'StatefulSet'",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the workload",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Namespace of the workload. Defaults to the release namespace",
+						},
+					},
+				},
+			},
+			"drift_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "correct",
+				ValidateFunc: validation.StringInSlice([]string{"correct", "warn", "ignore"}, false),
+				Description:  "What to do when the live release values or chart version differ from state: 'correct' plans an upgrade back to the declared config, 'warn' only emits a warning, 'ignore' does nothing (default: 'correct')",
+			},
+			"service_account": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Have the platform create a service account for the release with only the declared RBAC rules, instead of cluster-admin defaults",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service account name. If not specified, the platform derives one from the release name",
+						},
+						"cluster_scoped": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Bind the rules with a ClusterRole instead of a namespaced Role (default: false)",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "RBAC rules granted to the service account",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_groups": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "API groups, use \"\" for the core group",
+									},
+									"resources": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types (e.g., 'configmaps')",
+									},
+									"verbs": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Allowed verbs (e.g., 'get', 'list', 'watch')",
+									},
+									"resource_names": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Restrict the rule to specific object names",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"canary": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Roll out upgrades progressively: shift traffic to the new revision in steps, analysing it between steps",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"steps": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(1, 100)},
+							Description: "Strictly increasing traffic weights, in percent, shifted to the new revision (e.g., [10, 25, 50, 100])",
+						},
+						"analysis_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1m",
+							ValidateFunc: validateDuration,
+							Description:  "Time between analysis runs before moving to the next step, as a Go duration (default: '1m')",
+						},
+						"auto_promote": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Promote the new revision automatically after the last step passes analysis. When false, promotion is left to the platform console (default: true)",
+						},
+					},
+				},
+			},
+			"service_account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the service account the platform created for the release",
+			},
+			"include_manifest": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fetch the rendered manifest of the deployed revision into 'manifest' (default: false)",
+			},
+			"revision": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Revision number of the deployed release",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Release status reported by Helm (e.g., 'deployed', 'failed')",
+			},
+			"app_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application version of the deployed chart",
+			},
+			"manifest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rendered manifest of the deployed revision. Only set when include_manifest is true",
+			},
 		},
 	}
 }
@@ -84,27 +484,156 @@ func buildHelmPayload(d *schema.ResourceData) (*HelmInstallPayload, error) {
 		Namespace:   d.Get("namespace").(string),
 		Release:     d.Get("release").(string),
 		Chart:       d.Get("chart").(string),
-	}
+		Repo:        d.Get("repo").(string),
+		Version:     d.Get("chart_version").(string),
 
-	// Handle values - prefer values_file if both are provided
-	valuesFile := d.Get("values_file").(string)
-	values := d.Get("values").(string)
+		Labels:          expandStringMap(d.Get("labels").(map[string]interface{})),
+		CreateNamespace: d.Get("create_namespace").(bool),
+		SkipCrds:        d.Get("skip_crds").(bool),
+	}
 
-	if valuesFile != "" {
-		// Read values from file
-		fileContent, err := os.ReadFile(valuesFile)
+	docs := expandStringList(d.Get("values").([]interface{}))
+	if path := d.Get("values_template_file").(string); path != "" {
+		rendered, err := renderValuesTemplate(path, expandStringMap(d.Get("template_vars").(map[string]interface{})))
 		if err != nil {
-			return nil, fmt.Errorf("failed to read values file %s: %w", valuesFile, err)
+			return nil, err
+		}
+		docs = append([]string{rendered}, docs...)
+	}
+
+	values, err := mergeYAMLDocuments(docs)
+	if err != nil {
+		return nil, err
+	}
+	payload.Values = values
+
+	sets := expandHelmSetValues(d.Get("set").([]interface{}))
+	sets = append(sets, expandHelmSetValues(d.Get("set_sensitive").([]interface{}))...)
+	merged, err := applyHelmSetValues(payload.Values, sets)
+	if err != nil {
+		return nil, err
+	}
+	payload.Values = merged
+
+	payload.ServiceAccount = expandHelmServiceAccount(d.Get("service_account").([]interface{}))
+	payload.Canary = expandHelmCanary(d.Get("canary").([]interface{}))
+
+	if extra := d.Get("extra_payload").(string); extra != "" {
+		if err := json.Unmarshal([]byte(extra), &payload.Extra); err != nil {
+			return nil, fmt.Errorf("extra_payload must be a JSON object: %w", err)
 		}
-		payload.Values = string(fileContent)
-	} else if values != "" {
-		// Use inline values
-		payload.Values = values
 	}
 
 	return payload, nil
 }
 
+// expandHelmSetValues converts set/set_sensitive blocks to helmSetValue overrides.
+func expandHelmSetValues(blocks []interface{}) []helmSetValue {
+	sets := make([]helmSetValue, 0, len(blocks))
+	for _, b := range blocks {
+		raw := b.(map[string]interface{})
+		sets = append(sets, helmSetValue{
+			Name:  raw["name"].(string),
+			Value: raw["value"].(string),
+			Type:  raw["type"].(string),
+		})
+	}
+	return sets
+}
+
+// expandHelmCanary converts the canary block to its API representation.
+func expandHelmCanary(blocks []interface{}) *HelmCanary {
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	raw := blocks[0].(map[string]interface{})
+
+	canary := &HelmCanary{
+		AnalysisInterval: raw["analysis_interval"].(string),
+		AutoPromote:      raw["auto_promote"].(bool),
+	}
+	for _, step := range raw["steps"].([]interface{}) {
+		canary.Steps = append(canary.Steps, step.(int))
+	}
+	return canary
+}
+
+// resourceHelmReleaseCanaryDiff checks that canary steps only ever increase the traffic weight.
+func resourceHelmReleaseCanaryDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("canary") {
+		return nil
+	}
+	canary := expandHelmCanary(d.Get("canary").([]interface{}))
+	if canary == nil {
+		return nil
+	}
+	for i := 1; i < len(canary.Steps); i++ {
+		if canary.Steps[i] <= canary.Steps[i-1] {
+			return fmt.Errorf("canary steps must be strictly increasing, got %d after %d", canary.Steps[i], canary.Steps[i-1])
+		}
+	}
+	return nil
+}
+
+// resourceHelmReleaseValuesTemplateDiff renders values_template_file during plan and
+// records a checksum of the output, since the file content is not part of the configuration.
+func resourceHelmReleaseValuesTemplateDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("values_template_file") || !d.NewValueKnown("template_vars") {
+		return d.SetNewComputed("values_template_sha256")
+	}
+
+	sum := ""
+	if path := d.Get("values_template_file").(string); path != "" {
+		rendered, err := renderValuesTemplate(path, expandStringMap(d.Get("template_vars").(map[string]interface{})))
+		if err != nil {
+			return err
+		}
+		sum = exportChecksum(rendered)
+	}
+
+	if sum != d.Get("values_template_sha256").(string) {
+		return d.SetNew("values_template_sha256", sum)
+	}
+	return nil
+}
+
+// expandHelmServiceAccount converts the service_account block to its API representation.
+func expandHelmServiceAccount(blocks []interface{}) *HelmServiceAccount {
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	raw := blocks[0].(map[string]interface{})
+
+	sa := &HelmServiceAccount{
+		Name:          raw["name"].(string),
+		ClusterScoped: raw["cluster_scoped"].(bool),
+	}
+	for _, r := range raw["rule"].([]interface{}) {
+		rule := r.(map[string]interface{})
+		sa.Rules = append(sa.Rules, HelmRBACRule{
+			APIGroups:     expandStringList(rule["api_groups"].([]interface{})),
+			Resources:     expandStringList(rule["resources"].([]interface{})),
+			Verbs:         expandStringList(rule["verbs"].([]interface{})),
+			ResourceNames: expandStringList(rule["resource_names"].([]interface{})),
+		})
+	}
+	return sa
+}
+
+// expandStringList converts a Terraform list attribute to []string.
+func expandStringList(in []interface{}) []string {
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if strVal, ok := v.(string); ok {
+			out = append(out, strVal)
+		} else {
+			// Empty strings arrive as nil (e.g., the core API group "").
+			out = append(out, "")
+		}
+	}
+	return out
+}
+
 // resourceHelmReleaseCreate calls POST /helm_install.
 func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client, ok := m.(*apiClient)
@@ -117,16 +646,328 @@ func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	body, err := json.Marshal(payload)
+	if diags := postHelmPayload(ctx, client, "helm_install", payload); diags.HasError() {
+		return diags
+	}
+
+	// Use a composite ID: cluster_name:namespace:release
+	resourceID := fmt.Sprintf("%s:%s:%s", payload.Clustername, payload.Namespace, payload.Release)
+	d.SetId(resourceID)
+
+	if err := waitForHelmRelease(ctx, client, d, payload); err != nil {
+		if isInterrupted(err) {
+			return interruptedDiags(
+				fmt.Sprintf("Interrupted while waiting for Helm release %s to become ready", payload.Release),
+				fmt.Sprintf("Release %s was installed in cluster %s and is recorded in state as tainted. Run `terraform untaint` on this resource to keep it, or `terraform apply` to reinstall it.", payload.Release, payload.Clustername),
+			)
+		}
+		if d.Get("atomic").(bool) {
+			log.Printf("[WARN] Helm release %s did not become ready, uninstalling (atomic): %v", payload.Release, err)
+			if diags := resourceHelmReleaseDelete(ctx, d, m); diags.HasError() {
+				return append(diags, diag.Errorf("Helm release %s did not become ready and could not be uninstalled: %v", payload.Release, err)...)
+			}
+			return diag.Errorf("Helm release %s did not become ready and was uninstalled: %v", payload.Release, err)
+		}
+		return diag.Errorf("Helm release %s did not become ready: %v", payload.Release, err)
+	}
+
+	log.Printf("[INFO] successfully installed Helm release %s in cluster %s", payload.Release, payload.Clustername)
+	return resourceHelmReleaseRead(ctx, d, m)
+}
+
+// resourceHelmReleaseRead refreshes the release from /helm_releases and applies the drift_policy.
+func resourceHelmReleaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	clustername := d.Get("cluster_name").(string)
+	namespace := d.Get("namespace").(string)
+	release := d.Get("release").(string)
+
+	info, err := fetchHelmRelease(ctx, client, clustername, namespace, release)
+	if err != nil {
+		return client.softFailure("failed to read Helm release %s in cluster %s: %v", release, clustername, err)
+	}
+	if info == nil {
+		// Release was uninstalled out-of-band; mark resource as gone.
+		log.Printf("[INFO] Helm release %s not found in cluster %s, removing from state", release, clustername)
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("service_account_name", info.ServiceAccount)
+	_ = d.Set("revision", info.Revision)
+	_ = d.Set("status", info.Status)
+	_ = d.Set("app_version", info.AppVersion)
+
+	if d.Get("include_manifest").(bool) {
+		manifest, err := fetchHelmManifest(ctx, client, clustername, namespace, release)
+		if err != nil {
+			if diags := client.softFailure("failed to fetch manifest of Helm release %s in cluster %s: %v", release, clustername, err); diags.HasError() {
+				return diags
+			}
+		} else {
+			_ = d.Set("manifest", manifest)
+		}
+	} else {
+		_ = d.Set("manifest", "")
+	}
+
+	// Imported releases only know their ID; fill in what the API reports.
+	if d.Get("chart").(string) == "" {
+		_ = d.Set("chart", info.Chart)
+		_ = d.Set("chart_version", info.ChartVersion)
+	}
+
+	policy := d.Get("drift_policy").(string)
+	if policy == "ignore" {
+		return nil
+	}
+
+	var drifted []string
+
+	declared, err := buildHelmPayload(d)
+	if err != nil {
+		if diags := client.softFailure("failed to build declared values for Helm release %s: %v", release, err); diags.HasError() {
+			return diags
+		}
+	} else if !yamlEquivalent(declared.Values, info.Values) {
+		drifted = append(drifted, "values")
+	}
+
+	chartVersion := d.Get("chart_version").(string)
+	if chartVersion != "" && info.ChartVersion != "" && info.ChartVersion != chartVersion {
+		drifted = append(drifted, "chart_version")
+	}
+
+	if len(drifted) == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Helm release %s in cluster %s drifted (%v), drift_policy=%s", release, clustername, drifted, policy)
+
+	if policy == "warn" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Helm release %s has drifted from its declared configuration", release),
+				Detail:   fmt.Sprintf("Live %v of release %s in cluster %s differ from state. drift_policy is 'warn', so no changes will be planned.", drifted, release, clustername),
+			},
+		}
+	}
+
+	// policy == "correct": record the live values so the next plan upgrades back to config.
+	for _, field := range drifted {
+		switch field {
+		case "values":
+			if len(d.Get("set_sensitive").([]interface{})) > 0 {
+				// Live values contain the sensitive overrides; never copy them into a plain attribute.
+				_ = d.Set("values", []string{helmRedactedValues})
+			} else {
+				_ = d.Set("values", []string{info.Values})
+			}
+		case "chart_version":
+			_ = d.Set("chart_version", info.ChartVersion)
+		}
+	}
+
+	return nil
+}
+
+// resourceHelmReleaseUpdate calls POST /helm_upgrade with the new chart version and values.
+func resourceHelmReleaseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	if !d.HasChanges("values", "values_template_sha256", "set", "set_sensitive", "chart_version", "service_account", "extra_payload", "labels", "canary", "create_namespace", "skip_crds") {
+		return resourceHelmReleaseRead(ctx, d, m)
+	}
+
+	payload, err := buildHelmPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The revision in state is the one to return to if the upgrade fails.
+	previousRevision := 0
+	if old, _ := d.GetChange("revision"); old != nil {
+		previousRevision = old.(int)
+	}
+	rollbackOnFailure := d.Get("rollback_on_failure").(bool) || d.Get("atomic").(bool)
+
+	if diags := postHelmPayload(ctx, client, "helm_upgrade", payload); diags.HasError() {
+		d.Partial(true)
+		if rollbackOnFailure && !isInterrupted(ctx.Err()) {
+			log.Printf("[WARN] upgrade of Helm release %s failed, rolling back to revision %d", payload.Release, previousRevision)
+			if rbDiags := rollbackHelmRelease(ctx, client, payload, previousRevision); rbDiags.HasError() {
+				return append(diags, rbDiags...)
+			}
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Helm release %s was rolled back after the failed upgrade", payload.Release),
+			})
+		}
+		return diags
+	}
+
+	if err := waitForHelmRelease(ctx, client, d, payload); err != nil {
+		// Keep the previous state so the next plan retries the upgrade.
+		d.Partial(true)
+		if isInterrupted(err) {
+			return interruptedDiags(
+				fmt.Sprintf("Interrupted while waiting for Helm release %s to become ready", payload.Release),
+				"The upgrade was submitted but not verified. Run `terraform apply` again to check the release and retry the upgrade if needed.",
+			)
+		}
+		if rollbackOnFailure {
+			log.Printf("[WARN] Helm release %s did not become ready, rolling back to revision %d: %v", payload.Release, previousRevision, err)
+			if diags := rollbackHelmRelease(ctx, client, payload, previousRevision); diags.HasError() {
+				return append(diags, diag.Errorf("Helm release %s did not become ready and could not be rolled back: %v", payload.Release, err)...)
+			}
+			return diag.Errorf("Helm release %s did not become ready and was rolled back to its previous revision: %v", payload.Release, err)
+		}
+		return diag.Errorf("Helm release %s did not become ready: %v", payload.Release, err)
+	}
+
+	log.Printf("[INFO] successfully upgraded Helm release %s in cluster %s", payload.Release, payload.Clustername)
+	return resourceHelmReleaseRead(ctx, d, m)
+}
+
+// waitForHelmRelease polls /helm_releases until the release is deployed with all pods ready
+// when wait or atomic is set, then waits for each readiness_check workload to become ready.
+func waitForHelmRelease(ctx context.Context, client *apiClient, d *schema.ResourceData, payload *HelmInstallPayload) error {
+	checks := expandHelmReadinessChecks(d.Get("readiness_check").([]interface{}), payload.Namespace)
+	waitRelease := d.Get("wait").(bool) || d.Get("atomic").(bool)
+	if !waitRelease && len(checks) == 0 {
+		return nil
+	}
+
+	// The release and all readiness checks share a single timeout.
+	deadline := time.Now().Add(time.Duration(d.Get("timeout").(int)) * time.Second)
+
+	if waitRelease {
+		_, err := waitFor(ctx, func(ctx context.Context) (string, bool, error) {
+			info, err := fetchHelmRelease(ctx, client, payload.Clustername, payload.Namespace, payload.Release)
+			if err != nil {
+				return "", false, err
+			}
+			if info == nil {
+				return "", false, nil
+			}
+			ready := info.Ready == nil || *info.Ready
+			return info.Status, info.Status == "deployed" && ready, nil
+		}, WaitConfig{
+			Timeout:           time.Until(deadline),
+			InitialInterval:   5 * time.Second,
+			MaxInterval:       15 * time.Second,
+			BackoffMultiplier: 1.5,
+			FailureStates:     []string{"failed"},
+			OnProgress: func(attempt int, state string, err error) {
+				if err == nil && state != "" {
+					log.Printf("[INFO] Helm release %s status: %s", payload.Release, state)
+				}
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, check := range checks {
+		if err := waitForHelmWorkload(ctx, client, payload.Clustername, check, time.Until(deadline)); err != nil {
+			return fmt.Errorf("%s %s/%s did not become ready: %w", check.Kind, check.Namespace, check.Name, err)
+		}
+	}
+	return nil
+}
+
+// waitForHelmWorkload polls /workload_status until the workload reports Ready.
+// A workload the backend does not know yet is treated as not ready.
+func waitForHelmWorkload(ctx context.Context, client *apiClient, clustername string, check helmReadinessCheck, timeout time.Duration) error {
+	if timeout <= 0 {
+		return fmt.Errorf("timeout expired before the check started")
+	}
+
+	_, err := waitFor(ctx, func(ctx context.Context) (string, bool, error) {
+		status, err := fetchHelmWorkloadStatus(ctx, client, clustername, check)
+		if err != nil {
+			return "", false, err
+		}
+		if status == nil {
+			return "missing", false, nil
+		}
+		return fmt.Sprintf("%d/%d ready", status.ReadyReplicas, status.Replicas), status.Ready, nil
+	}, WaitConfig{
+		Timeout:           timeout,
+		InitialInterval:   5 * time.Second,
+		MaxInterval:       15 * time.Second,
+		BackoffMultiplier: 1.5,
+		OnProgress: func(attempt int, state string, err error) {
+			if err == nil && state != "" {
+				log.Printf("[INFO] %s %s/%s: %s", check.Kind, check.Namespace, check.Name, state)
+			}
+		},
+	})
+	return err
+}
+
+// expandHelmReadinessChecks converts readiness_check blocks, defaulting the namespace to the release's.
+func expandHelmReadinessChecks(blocks []interface{}, releaseNamespace string) []helmReadinessCheck {
+	checks := make([]helmReadinessCheck, 0, len(blocks))
+	for _, b := range blocks {
+		raw := b.(map[string]interface{})
+		check := helmReadinessCheck{
+			Kind:      raw["kind"].(string),
+			Name:      raw["name"].(string),
+			Namespace: raw["namespace"].(string),
+		}
+		if check.Namespace == "" {
+			check.Namespace = releaseNamespace
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// postHelmPayload sends payload to POST /<endpoint> (helm_install or helm_upgrade).
+func postHelmPayload(ctx context.Context, client *apiClient, endpoint string, payload *HelmInstallPayload) diag.Diagnostics {
+	body, err := marshalHelmPayload(payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	return postHelmJSON(ctx, client, endpoint, body)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/helm_install", client.BaseURL), bytes.NewReader(body))
+// rollbackHelmRelease calls POST /helm_rollback to return the release to revision.
+// A zero revision lets the backend pick the revision before the current one.
+func rollbackHelmRelease(ctx context.Context, client *apiClient, payload *HelmInstallPayload, revision int) diag.Diagnostics {
+	body, err := json.Marshal(HelmRollbackPayload{
+		Clustername: payload.Clustername,
+		Namespace:   payload.Namespace,
+		Release:     payload.Release,
+		Revision:    revision,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return postHelmJSON(ctx, client, "helm_rollback", body)
+}
+
+// postHelmJSON sends a JSON body to POST /<endpoint>. Failed requests are only retried
+// with idempotency keys enabled, since a retried install could deploy the release twice.
+func postHelmJSON(ctx context.Context, client *apiClient, endpoint string, body []byte) diag.Diagnostics {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%s", client.BaseURL, endpoint), bytes.NewReader(body))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
+	}
 	// Check if token already includes "Bearer " prefix, if not add it
 	authHeader := client.Token
 	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
@@ -134,15 +975,6 @@ func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m in
 	}
 	req.Header.Set("Authorization", authHeader)
 
-	// Set GetBody for retry support
-	if req.Body != nil {
-		bodyBytes, _ := io.ReadAll(req.Body)
-		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-		req.GetBody = func() (io.ReadCloser, error) {
-			return io.NopCloser(bytes.NewReader(bodyBytes)), nil
-		}
-	}
-
 	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
 	if diags != nil && diags.HasError() {
 		return diags
@@ -152,7 +984,7 @@ func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m in
 	// Always read the response body
 	bodyBytes, readErr := io.ReadAll(resp.Body)
 	if readErr != nil {
-		log.Printf("[WARN] failed to read helm_install response body: %v", readErr)
+		log.Printf("[WARN] failed to read %s response body: %v", endpoint, readErr)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -160,38 +992,31 @@ func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m in
 		if bodyStr == "" {
 			bodyStr = "(no response body)"
 		}
-		return diag.Errorf("helm_install failed: %s: %s", resp.Status, bodyStr)
+		return diag.Errorf("%s failed: %s: %s", endpoint, resp.Status, bodyStr)
 	}
 
-	// Use a composite ID: cluster_name:namespace:release
-	resourceID := fmt.Sprintf("%s:%s:%s", payload.Clustername, payload.Namespace, payload.Release)
-	d.SetId(resourceID)
-
-	log.Printf("[INFO] successfully installed Helm release %s in cluster %s", payload.Release, payload.Clustername)
-	return resourceHelmReleaseRead(ctx, d, m)
-}
-
-// resourceHelmReleaseRead is a stub - you can extend this if your API supports reading Helm releases.
-func resourceHelmReleaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// TODO: Implement read if your API supports GET /helm_releases or similar
-	// For now, we assume the release exists if the resource is in state
 	return nil
 }
 
-// resourceHelmReleaseUpdate handles updates by reinstalling with new values.
-func resourceHelmReleaseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// If any of the immutable fields changed, we need to recreate
-	if d.HasChanges("cluster_name", "namespace", "release", "chart", "repo", "chart_version") {
-		// These changes require recreation
-		return diag.Errorf("cannot change cluster_name, namespace, release, chart, repo, or chart_version. These require recreation")
+// marshalHelmPayload encodes payload and merges its Extra fields into the top-level object.
+// Extra fields may not override fields the provider manages.
+func marshalHelmPayload(payload *HelmInstallPayload) ([]byte, error) {
+	body, err := json.Marshal(payload)
+	if err != nil || len(payload.Extra) == 0 {
+		return body, err
 	}
 
-	// If only values changed, reinstall with new values
-	if d.HasChanges("values", "values_file") {
-		return resourceHelmReleaseCreate(ctx, d, m)
+	var merged map[string]interface{}
+	if err := json.Unmarshal(body, &merged); err != nil {
+		return nil, err
 	}
-
-	return resourceHelmReleaseRead(ctx, d, m)
+	for k, v := range payload.Extra {
+		if _, exists := merged[k]; exists {
+			return nil, fmt.Errorf("extra_payload field %q conflicts with a field managed by the provider", k)
+		}
+		merged[k] = v
+	}
+	return json.Marshal(merged)
 }
 
 // resourceHelmReleaseDelete calls DELETE /deleteapp?Name=<namespace><release> to delete the app.
@@ -210,28 +1035,31 @@ func resourceHelmReleaseDelete(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	clustername := parts[0]
-	release := parts[2] // parts[1] is kubernetes namespace, not cluster namespace
+	namespace := parts[1]
+	release := parts[2]
 
-	// Get cluster namespace by fetching cluster info
-	var appName string
-	clusterInfo, err := fetchClusterInfo(ctx, client, clustername)
-	if err != nil {
-		log.Printf("[WARN] failed to fetch cluster %s info to get namespace: %v", clustername, err)
-		// Try to use release name directly if we can't get cluster namespace
-		appName = release
-		log.Printf("[WARN] falling back to using release name %s directly", appName)
-	} else if clusterInfo == nil || clusterInfo.NameSpace == "" {
-		log.Printf("[WARN] cluster %s not found or namespace is empty, using release name directly", clustername)
-		appName = release
-	} else {
-		// Use {cluster_namespace}-{release} as the app name
-		appName = clusterInfo.NameSpace + "-" + release
-		log.Printf("[DEBUG] Using app name %s (namespace: %s + release: %s)", appName, clusterInfo.NameSpace, release)
+	appName, diags := resolveHelmAppName(ctx, client, clustername, namespace, release)
+	if diags.HasError() {
+		return diags
 	}
 
 	// Build the delete URL with query parameter Name=<appName>
-	// API endpoint: DELETE /deleteapp?Name=<namespace><release>
+	// API endpoint: DELETE /deleteapp?Name=<app name>
 	deleteURL := fmt.Sprintf("%s/deleteapp?Name=%s", client.BaseURL, url.QueryEscape(appName))
+
+	// Forward uninstall options; hook jobs in some charts otherwise hang the call indefinitely.
+	uninstallTimeout := d.Get("uninstall_timeout").(int)
+	deleteURL += fmt.Sprintf("&Timeout=%d", uninstallTimeout)
+	if d.Get("uninstall_keep_history").(bool) {
+		deleteURL += "&KeepHistory=true"
+	}
+	if d.Get("uninstall_no_hooks").(bool) {
+		deleteURL += "&NoHooks=true"
+	}
+
+	// Give the backend its full timeout plus some slack to respond before giving up.
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(uninstallTimeout)*time.Second+30*time.Second)
+	defer cancel()
 	log.Printf("[INFO] Attempting to delete Helm release %s (app name: %s) from cluster %s via %s", release, appName, clustername, deleteURL)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, deleteURL, nil)
@@ -247,7 +1075,8 @@ func resourceHelmReleaseDelete(ctx context.Context, d *schema.ResourceData, m in
 	}
 	req.Header.Set("Authorization", authHeader)
 
-	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	// The context above bounds the call; the client timeout could be shorter than uninstall_timeout.
+	resp, diags := doRequestWithRetryDiag(ctx, client.withoutTimeout(), req, client.RetryConfig)
 	if diags != nil && diags.HasError() {
 		// Log the error details for debugging
 		log.Printf("[ERROR] Delete API call failed for Helm release %s (app name: %s): %v", release, appName, diags)
@@ -290,6 +1119,240 @@ func resourceHelmReleaseDelete(ctx context.Context, d *schema.ResourceData, m in
 	return nil
 }
 
+// resourceHelmReleaseImport parses a cluster_name:namespace:release ID into its attributes.
+func resourceHelmReleaseImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := splitResourceID(d.Id())
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid import ID %q, expected cluster_name:namespace:release", d.Id())
+	}
+
+	_ = d.Set("cluster_name", parts[0])
+	_ = d.Set("namespace", parts[1])
+	_ = d.Set("release", parts[2])
+	_ = d.Set("drift_policy", "correct")
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// fetchHelmRelease queries /helm_releases?Clustername=<name> and returns the matching release.
+func fetchHelmRelease(ctx context.Context, client *apiClient, clustername, namespace, release string) (*HelmReleaseInfo, error) {
+	list, err := fetchHelmReleases(ctx, client, clustername)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range list {
+		if r.Release == release && r.Namespace == namespace {
+			return &r, nil
+		}
+	}
+	return nil, nil
+}
+
+// fetchHelmReleases queries /helm_releases and returns all releases in the cluster.
+// Returns nil, nil when the API reports the cluster as not found.
+func fetchHelmReleases(ctx context.Context, client *apiClient, clustername string) ([]HelmReleaseInfo, error) {
+	u := fmt.Sprintf("%s/helm_releases?Clustername=%s", client.BaseURL, url.QueryEscape(clustername))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("helm releases fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var list []HelmReleaseInfo
+	if err := decodeAPIResponse(resp, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// resolveHelmAppName finds the platform app record backing a release via /apps, so
+// /deleteapp targets exactly that app. When the app list is unavailable or has no match
+// it falls back to the legacy {cluster_namespace}-{release} naming.
+func resolveHelmAppName(ctx context.Context, client *apiClient, clustername, namespace, release string) (string, diag.Diagnostics) {
+	apps, err := fetchApps(ctx, client, clustername)
+	if err != nil {
+		log.Printf("[WARN] failed to list apps of cluster %s, falling back to derived app name: %v", clustername, err)
+	} else {
+		var matches []AppInfo
+		for _, app := range apps {
+			if app.Release == release && app.Namespace == namespace {
+				matches = append(matches, app)
+			}
+		}
+		switch len(matches) {
+		case 1:
+			log.Printf("[DEBUG] Using app name %s for release %s/%s", matches[0].Name, namespace, release)
+			return matches[0].Name, nil
+		case 0:
+			log.Printf("[WARN] no app found for release %s/%s in cluster %s, falling back to derived app name", namespace, release, clustername)
+		default:
+			names := make([]string, 0, len(matches))
+			for _, app := range matches {
+				names = append(names, app.Name)
+			}
+			return "", diag.Errorf("release %s/%s in cluster %s matches several apps (%v); refusing to guess which one to delete", namespace, release, clustername, names)
+		}
+	}
+
+	clusterInfo, err := fetchClusterInfo(ctx, client, clustername)
+	if err != nil {
+		if diags := client.softFailure("failed to fetch cluster %s info to get namespace: %v", clustername, err); diags.HasError() {
+			return "", diags
+		}
+		// Try to use release name directly if we can't get cluster namespace
+		log.Printf("[WARN] falling back to using release name %s directly", release)
+		return release, nil
+	}
+	if clusterInfo == nil || clusterInfo.NameSpace == "" {
+		log.Printf("[WARN] cluster %s not found or namespace is empty, using release name directly", clustername)
+		return release, nil
+	}
+	appName := clusterInfo.NameSpace + "-" + release
+	log.Printf("[DEBUG] Using app name %s (namespace: %s + release: %s)", appName, clusterInfo.NameSpace, release)
+	return appName, nil
+}
+
+// fetchApps queries GET /apps and returns the app records of a cluster, or of every
+// cluster when clustername is empty.
+func fetchApps(ctx context.Context, client *apiClient, clustername string) ([]AppInfo, error) {
+	u := fmt.Sprintf("%s/apps", client.BaseURL)
+	if clustername != "" {
+		u += "?Clustername=" + url.QueryEscape(clustername)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("apps fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var list []AppInfo
+	if err := decodeAPIResponse(resp, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode apps response: %w", err)
+	}
+	return list, nil
+}
+
+// fetchHelmManifest queries /helm_manifest and returns the rendered manifest of the deployed revision.
+func fetchHelmManifest(ctx context.Context, client *apiClient, clustername, namespace, release string) (string, error) {
+	u := fmt.Sprintf("%s/helm_manifest?Clustername=%s&Namespace=%s&Release=%s", client.BaseURL,
+		url.QueryEscape(clustername), url.QueryEscape(namespace), url.QueryEscape(release))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "*/*")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("helm manifest fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read helm manifest response: %w", err)
+	}
+	return string(body), nil
+}
+
+// fetchHelmWorkloadStatus queries GET /workload_status for a single workload.
+// Returns nil, nil when the workload does not exist (yet).
+func fetchHelmWorkloadStatus(ctx context.Context, client *apiClient, clustername string, check helmReadinessCheck) (*HelmWorkloadStatus, error) {
+	u := fmt.Sprintf("%s/workload_status?Clustername=%s&Namespace=%s&Kind=%s&Name=%s", client.BaseURL,
+		url.QueryEscape(clustername), url.QueryEscape(check.Namespace), url.QueryEscape(check.Kind), url.QueryEscape(check.Name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// Check if token already includes "Bearer " prefix, if not add it
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("workload status fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var status HelmWorkloadStatus
+	if err := decodeAPIResponse(resp, &status); err != nil {
+		return nil, fmt.Errorf("failed to decode workload status response: %w", err)
+	}
+	return &status, nil
+}
+
 // splitResourceID splits the composite ID into its components.
 func splitResourceID(id string) []string {
 	// ID format: cluster_name:namespace:release
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceHelmReleaseV0 is the bugx_helm_release schema as it was before values became a list.
+// It is only used to decode version 0 states for resourceHelmReleaseStateUpgradeV0.
+func resourceHelmReleaseV0() *schema.Resource {
+	setValue := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Required: true},
+			"value": {Type: schema.TypeString, Required: true},
+			"type":  {Type: schema.TypeString, Optional: true, Default: "auto"},
+		},
+	}
+	sensitiveSetValue := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Required: true},
+			"value": {Type: schema.TypeString, Required: true, Sensitive: true},
+			"type":  {Type: schema.TypeString, Optional: true, Default: "auto"},
+		},
+	}
+	rule := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"api_groups":     {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"resources":      {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"verbs":          {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"resource_names": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cluster_name":  {Type: schema.TypeString, Required: true},
+			"namespace":     {Type: schema.TypeString, Required: true},
+			"release":       {Type: schema.TypeString, Required: true},
+			"chart":         {Type: schema.TypeString, Required: true},
+			"repo":          {Type: schema.TypeString, Optional: true},
+			"values":        {Type: schema.TypeString, Optional: true},
+			"values_file":   {Type: schema.TypeString, Optional: true},
+			"set":           {Type: schema.TypeList, Optional: true, Elem: setValue},
+			"set_sensitive": {Type: schema.TypeList, Optional: true, Elem: sensitiveSetValue},
+			"chart_version": {Type: schema.TypeString, Optional: true},
+			"drift_policy":  {Type: schema.TypeString, Optional: true, Default: "correct"},
+			"service_account": {Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":           {Type: schema.TypeString, Optional: true},
+					"cluster_scoped": {Type: schema.TypeBool, Optional: true, Default: false},
+					"rule":           {Type: schema.TypeList, Required: true, MinItems: 1, Elem: rule},
+				},
+			}},
+			"service_account_name": {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+// resourceHelmReleaseStateUpgradeV0 migrates a version 0 bugx_helm_release state to version 1.
+// The single values string becomes a one-element values list. values_file used to take
+// precedence over values, so when it is set its content replaces the inline values.
+func resourceHelmReleaseStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	var values []interface{}
+	if v, _ := rawState["values"].(string); v != "" {
+		values = append(values, v)
+	}
+	if path, _ := rawState["values_file"].(string); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			// The next plan shows the file content as a values change, which is harmless.
+			log.Printf("[WARN] upgrading bugx_helm_release state v0 -> v1: could not read values_file %s: %v", path, err)
+		} else {
+			values = []interface{}{string(content)}
+		}
+	}
+
+	rawState["values"] = values
+	delete(rawState, "values_file")
+
+	return rawState, nil
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// IngressRulePayload represents the JSON body sent to create/update ingress rules.
+type IngressRulePayload struct {
+	ClusterName   string `json:"clusterName"`
+	Namespace     string `json:"namespace"`
+	Host          string `json:"host"`
+	Path          string `json:"path"`
+	PathType      string `json:"pathType"`
+	ServiceName   string `json:"serviceName"`
+	ServicePort   int    `json:"servicePort"`
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// IngressRuleInfo represents the JSON structure returned from the ingress rules API.
+type IngressRuleInfo struct {
+	ID            string `json:"id"`
+	ClusterName   string `json:"clusterName"`
+	Namespace     string `json:"namespace"`
+	Host          string `json:"host"`
+	Path          string `json:"path"`
+	PathType      string `json:"pathType"`
+	ServiceName   string `json:"serviceName"`
+	ServicePort   int    `json:"servicePort"`
+	TLSSecretName string `json:"tlsSecretName"`
+	URL           string `json:"url"`
+	Address       string `json:"address"`
+	Status        string `json:"status"`
+}
+
+// resourceIngressRule defines the bugx_ingress_rule resource schema and CRUD.
+// An ingress rule exposes a service inside a cluster on a hostname through the platform's ingress.
+func resourceIngressRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIngressRuleCreate,
+		ReadContext:   resourceIngressRuleRead,
+		UpdateContext: resourceIngressRuleUpdate,
+		DeleteContext: resourceIngressRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the service runs in",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace of the service (default: default)",
+			},
+			"host": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(hostnamePattern, "must be a lowercase DNS name, optionally starting with '*.'"),
+				Description:  "Hostname the service is exposed on (e.g., 'app.example.com')",
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "/",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with '/'"),
+				Description:  "URL path routed to the service (default: /)",
+			},
+			"path_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Prefix",
+				ValidateFunc: validation.StringInSlice([]string{"Prefix", "Exact"}, false),
+				Description:  "How path is matched: 'Prefix' or 'Exact' (default: Prefix)",
+			},
+			"service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the Kubernetes service to route to",
+			},
+			"service_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port of the Kubernetes service to route to",
+			},
+			"tls_secret_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of a kubernetes.io/tls secret in the namespace holding the certificate for host. If empty, the platform's certificate is used where it covers host, and plain HTTP otherwise",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL the service is reachable at",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address or hostname of the ingress load balancer, for DNS records",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Ingress status reported by the API (e.g., 'active', 'pending')",
+			},
+		},
+	}
+}
+
+// hostnamePattern matches a lowercase DNS name, optionally with a leading wildcard label.
+var hostnamePattern = regexp.MustCompile(`^(\*\.)?([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// buildIngressRulePayload converts Terraform state to API payload.
+func buildIngressRulePayload(d *schema.ResourceData) IngressRulePayload {
+	return IngressRulePayload{
+		ClusterName:   d.Get("cluster_name").(string),
+		Namespace:     d.Get("namespace").(string),
+		Host:          d.Get("host").(string),
+		Path:          d.Get("path").(string),
+		PathType:      d.Get("path_type").(string),
+		ServiceName:   d.Get("service_name").(string),
+		ServicePort:   d.Get("service_port").(int),
+		TLSSecretName: d.Get("tls_secret_name").(string),
+	}
+}
+
+// resourceIngressRuleCreate calls POST /network/api/v1/ingress-rules.
+func resourceIngressRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildIngressRulePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/network/api/v1/ingress-rules", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create ingress rule failed: %s: %s", resp.Status, string(b))
+	}
+
+	var rule IngressRuleInfo
+	if err := decodeAPIResponse(resp, &rule); err != nil {
+		return diag.Errorf("failed to decode create ingress rule response: %v", err)
+	}
+	if rule.ID == "" {
+		return diag.Errorf("create ingress rule succeeded but no id returned")
+	}
+
+	d.SetId(rule.ID)
+	log.Printf("[INFO] created ingress rule %s", rule.ID)
+	return resourceIngressRuleRead(ctx, d, m)
+}
+
+// resourceIngressRuleRead calls GET /network/api/v1/ingress-rules/:id.
+func resourceIngressRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	rule, err := fetchIngressRuleByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if rule == nil {
+		// Ingress rule not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", rule.ClusterName)
+	_ = d.Set("namespace", rule.Namespace)
+	_ = d.Set("host", rule.Host)
+	_ = d.Set("path", rule.Path)
+	_ = d.Set("path_type", rule.PathType)
+	_ = d.Set("service_name", rule.ServiceName)
+	_ = d.Set("service_port", rule.ServicePort)
+	_ = d.Set("tls_secret_name", rule.TLSSecretName)
+	_ = d.Set("url", rule.URL)
+	_ = d.Set("address", rule.Address)
+	_ = d.Set("status", rule.Status)
+
+	return nil
+}
+
+// resourceIngressRuleUpdate calls PUT /network/api/v1/ingress-rules/:id.
+func resourceIngressRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildIngressRulePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/network/api/v1/ingress-rules/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update ingress rule failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceIngressRuleRead(ctx, d, m)
+}
+
+// resourceIngressRuleDelete calls DELETE /network/api/v1/ingress-rules/:id.
+func resourceIngressRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/network/api/v1/ingress-rules/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] ingress rule %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete ingress rule failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted ingress rule %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchIngressRuleByID queries GET /network/api/v1/ingress-rules/:id and returns the ingress rule.
+func fetchIngressRuleByID(ctx context.Context, client *apiClient, id string) (*IngressRuleInfo, error) {
+	u := fmt.Sprintf("%s/network/api/v1/ingress-rules/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("ingress rule fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var rule IngressRuleInfo
+	if err := decodeAPIResponse(resp, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// LabelPolicyRule requires a label key and optionally restricts its values.
+type LabelPolicyRule struct {
+	Key           string   `json:"key"`
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// LabelPolicyPayload represents the JSON body sent to create/update label policies.
+type LabelPolicyPayload struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Targets     []string          `json:"targets"`
+	Rules       []LabelPolicyRule `json:"rules"`
+	Enforced    bool              `json:"enforced"`
+}
+
+// LabelPolicyInfo represents the JSON structure returned from the label policies API.
+type LabelPolicyInfo struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Targets     []string          `json:"targets"`
+	Rules       []LabelPolicyRule `json:"rules"`
+	Enforced    bool              `json:"enforced"`
+}
+
+// resourceLabelPolicy defines the bugx_label_policy resource schema and CRUD.
+// Enforced policies make the platform reject clusters and releases that lack required labels.
+func resourceLabelPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLabelPolicyCreate,
+		ReadContext:   resourceLabelPolicyRead,
+		UpdateContext: resourceLabelPolicyUpdate,
+		DeleteContext: resourceLabelPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the label policy",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-readable description of the policy",
+			},
+			"targets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice([]string{"cluster", "helm_release"}, false)},
+				Description: "Object kinds the policy applies to: 'cluster' and/or 'helm_release'",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Required labels",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Label key that must be present",
+						},
+						"allowed_values": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Values the label may take. If empty, any value is accepted",
+						},
+					},
+				},
+			},
+			"enforced": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Reject non-compliant objects. When false, the platform only reports violations (default: true)",
+			},
+		},
+	}
+}
+
+// buildLabelPolicyPayload converts Terraform state to API payload.
+func buildLabelPolicyPayload(d *schema.ResourceData) LabelPolicyPayload {
+	payload := LabelPolicyPayload{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enforced:    d.Get("enforced").(bool),
+	}
+
+	if targets, ok := d.Get("targets").(*schema.Set); ok {
+		for _, t := range targets.List() {
+			payload.Targets = append(payload.Targets, t.(string))
+		}
+	}
+
+	for _, raw := range d.Get("rule").([]interface{}) {
+		rule := raw.(map[string]interface{})
+		payload.Rules = append(payload.Rules, LabelPolicyRule{
+			Key:           rule["key"].(string),
+			AllowedValues: expandStringList(rule["allowed_values"].([]interface{})),
+		})
+	}
+
+	return payload
+}
+
+// resourceLabelPolicyCreate calls POST /policies/api/v1/label-policies.
+func resourceLabelPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildLabelPolicyPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/policies/api/v1/label-policies", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create label policy failed: %s: %s", resp.Status, string(b))
+	}
+
+	var policy LabelPolicyInfo
+	if err := decodeAPIResponse(resp, &policy); err != nil {
+		return diag.Errorf("failed to decode create label policy response: %v", err)
+	}
+	if policy.ID == "" {
+		return diag.Errorf("create label policy succeeded but no id returned")
+	}
+
+	d.SetId(policy.ID)
+	log.Printf("[INFO] created label policy %s", policy.ID)
+	return resourceLabelPolicyRead(ctx, d, m)
+}
+
+// resourceLabelPolicyRead calls GET /policies/api/v1/label-policies/:id.
+func resourceLabelPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	policy, err := fetchLabelPolicyByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if policy == nil {
+		// Label policy not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("targets", policy.Targets)
+	_ = d.Set("enforced", policy.Enforced)
+
+	rules := make([]map[string]interface{}, 0, len(policy.Rules))
+	for _, r := range policy.Rules {
+		rules = append(rules, map[string]interface{}{
+			"key":            r.Key,
+			"allowed_values": r.AllowedValues,
+		})
+	}
+	_ = d.Set("rule", rules)
+
+	return nil
+}
+
+// resourceLabelPolicyUpdate calls PUT /policies/api/v1/label-policies/:id.
+func resourceLabelPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildLabelPolicyPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/policies/api/v1/label-policies/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update label policy failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceLabelPolicyRead(ctx, d, m)
+}
+
+// resourceLabelPolicyDelete calls DELETE /policies/api/v1/label-policies/:id.
+func resourceLabelPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/policies/api/v1/label-policies/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] label policy %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete label policy failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted label policy %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchLabelPolicyByID queries GET /policies/api/v1/label-policies/:id and returns the label policy.
+func fetchLabelPolicyByID(ctx context.Context, client *apiClient, id string) (*LabelPolicyInfo, error) {
+	u := fmt.Sprintf("%s/policies/api/v1/label-policies/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("label policy fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var policy LabelPolicyInfo
+	if err := decodeAPIResponse(resp, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// fetchLabelPolicies queries GET /policies/api/v1/label-policies and returns all policies.
+func fetchLabelPolicies(ctx context.Context, client *apiClient) ([]LabelPolicyInfo, error) {
+	u := fmt.Sprintf("%s/policies/api/v1/label-policies", client.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("label policies fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var policies []LabelPolicyInfo
+	if err := decodeAPIResponse(resp, &policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// labelPolicyViolations lists the ways labels break the enforced policies for target.
+func labelPolicyViolations(policies []LabelPolicyInfo, target string, labels map[string]string) []string {
+	var violations []string
+	for _, p := range policies {
+		if !p.Enforced || !containsString(p.Targets, target) {
+			continue
+		}
+		for _, rule := range p.Rules {
+			value, ok := labels[rule.Key]
+			if !ok {
+				violations = append(violations, fmt.Sprintf("policy %q requires label %q", p.Name, rule.Key))
+				continue
+			}
+			if len(rule.AllowedValues) > 0 && !containsString(rule.AllowedValues, value) {
+				violations = append(violations, fmt.Sprintf("policy %q allows label %q to be one of [%s], got %q", p.Name, rule.Key, strings.Join(rule.AllowedValues, ", "), value))
+			}
+		}
+	}
+	sort.Strings(violations)
+	return violations
+}
+
+// labelPolicyDiff returns a CustomizeDiff that checks the labels attribute against the
+// platform's enforced label policies for target when the provider's preflight_checks
+// option is enabled, so guaranteed rejections surface at plan time.
+func labelPolicyDiff(target string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		client, ok := m.(*apiClient)
+		if !ok || client == nil || !client.PreflightChecks {
+			return nil
+		}
+		if !d.NewValueKnown("labels") || (d.Id() != "" && !d.HasChange("labels")) {
+			return nil
+		}
+
+		policies, err := fetchLabelPolicies(ctx, client)
+		if err != nil {
+			log.Printf("[WARN] preflight: failed to fetch label policies: %v", err)
+			return nil
+		}
+
+		labels := expandStringMap(d.Get("labels").(map[string]interface{}))
+		if violations := labelPolicyViolations(policies, target, labels); len(violations) > 0 {
+			return fmt.Errorf("labels violate enforced label policies:\n  - %s", strings.Join(violations, "\n  - "))
+		}
+		return nil
+	}
+}
+
+// containsString reports whether list contains s.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// LicensePayload represents the JSON body sent to apply/renew licenses.
+type LicensePayload struct {
+	LicenseKey  string `json:"licenseKey"`
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// LicenseInfo represents the JSON structure returned from the licenses API. The
+// license key itself is never returned.
+type LicenseInfo struct {
+	ID          string   `json:"id"`
+	ClusterName string   `json:"clusterName"`
+	Plan        string   `json:"plan"`
+	Features    []string `json:"features"`
+	SeatsTotal  int      `json:"seatsTotal"`
+	SeatsUsed   int      `json:"seatsUsed"`
+	IssuedAt    string   `json:"issuedAt"`
+	ExpiresAt   string   `json:"expiresAt"`
+	Status      string   `json:"status"`
+}
+
+// resourceLicense defines the bugx_license resource schema and CRUD.
+// A license applies a plan and its entitlements to the whole platform, or to one cluster.
+func resourceLicense() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLicenseCreate,
+		ReadContext:   resourceLicenseRead,
+		UpdateContext: resourceLicenseUpdate,
+		DeleteContext: resourceLicenseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"license_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "License key issued by bugx. Replace it with the renewed key to extend the license in place",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the license applies to. If empty, it applies to the whole platform",
+			},
+			"expiry_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Emit a warning on refresh when the license expires within this many days. 0 disables the warning (default: 30)",
+			},
+			"plan": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Plan the license grants (e.g., 'business', 'enterprise')",
+			},
+			"features": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Features the license entitles",
+			},
+			"seats_total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of user seats the license grants. 0 means unlimited",
+			},
+			"seats_used": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of seats currently in use",
+			},
+			"issued_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license was issued, in RFC 3339 format",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license expires, in RFC 3339 format",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "License status reported by the API (e.g., 'active', 'expired', 'grace')",
+			},
+		},
+	}
+}
+
+// buildLicensePayload converts Terraform state to API payload.
+func buildLicensePayload(d *schema.ResourceData) LicensePayload {
+	return LicensePayload{
+		LicenseKey:  d.Get("license_key").(string),
+		ClusterName: d.Get("cluster_name").(string),
+	}
+}
+
+// licenseExpiryDiags warns when a license expires within warnDays days.
+func licenseExpiryDiags(license *LicenseInfo, warnDays int) diag.Diagnostics {
+	if warnDays <= 0 || license.ExpiresAt == "" {
+		return nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, license.ExpiresAt)
+	if err != nil {
+		log.Printf("[WARN] license %s has an unparseable expiry %q: %v", license.ID, license.ExpiresAt, err)
+		return nil
+	}
+	remaining := time.Until(expiresAt)
+	if remaining > time.Duration(warnDays)*24*time.Hour {
+		return nil
+	}
+
+	scope := "the platform"
+	if license.ClusterName != "" {
+		scope = fmt.Sprintf("cluster %s", license.ClusterName)
+	}
+	summary := fmt.Sprintf("License for %s expires in %d days", scope, int(remaining.Hours()/24))
+	if remaining <= 0 {
+		summary = fmt.Sprintf("License for %s expired on %s", scope, expiresAt.Format("2006-01-02"))
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("Set license_key to the renewed key before %s to keep the licensed features.", expiresAt.Format(time.RFC3339)),
+		},
+	}
+}
+
+// resourceLicenseCreate calls POST /licensing/api/v1/licenses.
+func resourceLicenseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildLicensePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/licensing/api/v1/licenses", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create license failed: %s: %s", resp.Status, string(b))
+	}
+
+	var license LicenseInfo
+	if err := decodeAPIResponse(resp, &license); err != nil {
+		return diag.Errorf("failed to decode create license response: %v", err)
+	}
+	if license.ID == "" {
+		return diag.Errorf("create license succeeded but no id returned")
+	}
+
+	d.SetId(license.ID)
+	log.Printf("[INFO] created license %s", license.ID)
+	return resourceLicenseRead(ctx, d, m)
+}
+
+// resourceLicenseRead calls GET /licensing/api/v1/licenses/:id.
+func resourceLicenseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	license, err := fetchLicenseByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if license == nil {
+		// License not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", license.ClusterName)
+	_ = d.Set("plan", license.Plan)
+	_ = d.Set("features", license.Features)
+	_ = d.Set("seats_total", license.SeatsTotal)
+	_ = d.Set("seats_used", license.SeatsUsed)
+	_ = d.Set("issued_at", license.IssuedAt)
+	_ = d.Set("expires_at", license.ExpiresAt)
+	_ = d.Set("status", license.Status)
+	// license_key is never returned by the API; keep the configured value.
+
+	return licenseExpiryDiags(license, d.Get("expiry_warning_days").(int))
+}
+
+// resourceLicenseUpdate calls PUT /licensing/api/v1/licenses/:id.
+func resourceLicenseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildLicensePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/licensing/api/v1/licenses/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update license failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceLicenseRead(ctx, d, m)
+}
+
+// resourceLicenseDelete calls DELETE /licensing/api/v1/licenses/:id.
+func resourceLicenseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/licensing/api/v1/licenses/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] license %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete license failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted license %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchLicenseByID queries GET /licensing/api/v1/licenses/:id and returns the license.
+func fetchLicenseByID(ctx context.Context, client *apiClient, id string) (*LicenseInfo, error) {
+	u := fmt.Sprintf("%s/licensing/api/v1/licenses/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("license fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var license LicenseInfo
+	if err := decodeAPIResponse(resp, &license); err != nil {
+		return nil, err
+	}
+	return &license, nil
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// LimitRangeResources holds CPU and memory quantities for one kind of container limit.
+type LimitRangeResources struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+// LimitRangeContainer represents the container limits of a limit range.
+type LimitRangeContainer struct {
+	Default        LimitRangeResources `json:"default"`
+	DefaultRequest LimitRangeResources `json:"defaultRequest"`
+	Max            LimitRangeResources `json:"max"`
+	Min            LimitRangeResources `json:"min"`
+}
+
+// LimitRangePayload represents the JSON body sent to create/update limit ranges.
+type LimitRangePayload struct {
+	ClusterName string              `json:"clusterName"`
+	Namespace   string              `json:"namespace"`
+	Container   LimitRangeContainer `json:"container"`
+}
+
+// LimitRangeInfo represents the JSON structure returned from the limit ranges API.
+type LimitRangeInfo struct {
+	ID          string              `json:"id"`
+	ClusterName string              `json:"clusterName"`
+	Namespace   string              `json:"namespace"`
+	Container   LimitRangeContainer `json:"container"`
+}
+
+// resourceLimitRange defines the bugx_limit_range resource schema and CRUD.
+// A limit range sets default, minimum and maximum container requests and limits in a namespace.
+func resourceLimitRange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLimitRangeCreate,
+		ReadContext:   resourceLimitRangeRead,
+		UpdateContext: resourceLimitRangeUpdate,
+		DeleteContext: resourceLimitRangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceLimitRangeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the limit range applies to",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace inside the cluster the limit range applies to",
+			},
+			"default_cpu_request": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "CPU request given to containers that do not set one (e.g., '100m')",
+			},
+			"default_memory_request": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Memory request given to containers that do not set one (e.g., '128Mi')",
+			},
+			"default_cpu_limit": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "CPU limit given to containers that do not set one (e.g., '500m')",
+			},
+			"default_memory_limit": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Memory limit given to containers that do not set one (e.g., '512Mi')",
+			},
+			"min_cpu": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Smallest CPU request a container may set",
+			},
+			"min_memory": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Smallest memory request a container may set",
+			},
+			"max_cpu": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Largest CPU limit a container may set",
+			},
+			"max_memory": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Largest memory limit a container may set",
+			},
+		},
+	}
+}
+
+// buildLimitRangePayload converts Terraform state to API payload.
+func buildLimitRangePayload(d *schema.ResourceData) LimitRangePayload {
+	return LimitRangePayload{
+		ClusterName: d.Get("cluster_name").(string),
+		Namespace:   d.Get("namespace").(string),
+		Container: LimitRangeContainer{
+			Default: LimitRangeResources{
+				CPU:    d.Get("default_cpu_limit").(string),
+				Memory: d.Get("default_memory_limit").(string),
+			},
+			DefaultRequest: LimitRangeResources{
+				CPU:    d.Get("default_cpu_request").(string),
+				Memory: d.Get("default_memory_request").(string),
+			},
+			Max: LimitRangeResources{
+				CPU:    d.Get("max_cpu").(string),
+				Memory: d.Get("max_memory").(string),
+			},
+			Min: LimitRangeResources{
+				CPU:    d.Get("min_cpu").(string),
+				Memory: d.Get("min_memory").(string),
+			},
+		},
+	}
+}
+
+// resourceLimitRangeDiff rejects limit ranges Kubernetes would refuse, where the
+// quantities are not ordered min <= default request <= default limit <= max.
+func resourceLimitRangeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, res := range []string{"cpu", "memory"} {
+		chain := []string{"min_" + res, "default_" + res + "_request", "default_" + res + "_limit", "max_" + res}
+		prevKey, prev := "", 0.0
+		for _, key := range chain {
+			q, ok := parseQuantity(d.Get(key).(string))
+			if !ok {
+				// Unset or not yet known.
+				continue
+			}
+			if prevKey != "" && q < prev {
+				return fmt.Errorf("%s (%s) must not be less than %s (%s)", key, d.Get(key), prevKey, d.Get(prevKey))
+			}
+			prevKey, prev = key, q
+		}
+	}
+	return nil
+}
+
+// resourceLimitRangeCreate calls POST /quota/api/v1/limit-ranges.
+func resourceLimitRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildLimitRangePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/quota/api/v1/limit-ranges", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create limit range failed: %s: %s", resp.Status, string(b))
+	}
+
+	var lr LimitRangeInfo
+	if err := decodeAPIResponse(resp, &lr); err != nil {
+		return diag.Errorf("failed to decode create limit range response: %v", err)
+	}
+	if lr.ID == "" {
+		return diag.Errorf("create limit range succeeded but no id returned")
+	}
+
+	d.SetId(lr.ID)
+	log.Printf("[INFO] created limit range %s", lr.ID)
+	return resourceLimitRangeRead(ctx, d, m)
+}
+
+// resourceLimitRangeRead calls GET /quota/api/v1/limit-ranges/:id.
+func resourceLimitRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	lr, err := fetchLimitRangeByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if lr == nil {
+		// Limit range not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", lr.ClusterName)
+	_ = d.Set("namespace", lr.Namespace)
+	_ = d.Set("default_cpu_limit", lr.Container.Default.CPU)
+	_ = d.Set("default_memory_limit", lr.Container.Default.Memory)
+	_ = d.Set("default_cpu_request", lr.Container.DefaultRequest.CPU)
+	_ = d.Set("default_memory_request", lr.Container.DefaultRequest.Memory)
+	_ = d.Set("max_cpu", lr.Container.Max.CPU)
+	_ = d.Set("max_memory", lr.Container.Max.Memory)
+	_ = d.Set("min_cpu", lr.Container.Min.CPU)
+	_ = d.Set("min_memory", lr.Container.Min.Memory)
+
+	return nil
+}
+
+// resourceLimitRangeUpdate calls PUT /quota/api/v1/limit-ranges/:id.
+func resourceLimitRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildLimitRangePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/quota/api/v1/limit-ranges/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update limit range failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceLimitRangeRead(ctx, d, m)
+}
+
+// resourceLimitRangeDelete calls DELETE /quota/api/v1/limit-ranges/:id.
+func resourceLimitRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/quota/api/v1/limit-ranges/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] limit range %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete limit range failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted limit range %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchLimitRangeByID queries GET /quota/api/v1/limit-ranges/:id and returns the limit range.
+func fetchLimitRangeByID(ctx context.Context, client *apiClient, id string) (*LimitRangeInfo, error) {
+	u := fmt.Sprintf("%s/quota/api/v1/limit-ranges/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("limit range fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var lr LimitRangeInfo
+	if err := decodeAPIResponse(resp, &lr); err != nil {
+		return nil, err
+	}
+	return &lr, nil
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+	_ "time/tzdata" // validateTimezone must not depend on the host's zoneinfo
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// MaintenanceWindowPayload represents the JSON body sent to create/update maintenance windows.
+type MaintenanceWindowPayload struct {
+	ClusterName string   `json:"clusterName"`
+	Days        []string `json:"days"`
+	StartTime   string   `json:"startTime"`
+	Duration    string   `json:"duration"`
+	Timezone    string   `json:"timezone"`
+	Enabled     bool     `json:"enabled"`
+}
+
+// MaintenanceWindowInfo represents the JSON structure returned from the maintenance windows API.
+type MaintenanceWindowInfo struct {
+	ID              string   `json:"id"`
+	ClusterName     string   `json:"clusterName"`
+	Days            []string `json:"days"`
+	StartTime       string   `json:"startTime"`
+	Duration        string   `json:"duration"`
+	Timezone        string   `json:"timezone"`
+	Enabled         bool     `json:"enabled"`
+	NextWindowStart string   `json:"nextWindowStart"`
+}
+
+// resourceMaintenanceWindow defines the bugx_maintenance_window resource schema and CRUD.
+// The platform only runs automated upgrades and restarts of the cluster inside its maintenance windows.
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceWindowCreate,
+		ReadContext:   resourceMaintenanceWindowRead,
+		UpdateContext: resourceMaintenanceWindowUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the window applies to",
+			},
+			"days": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, false),
+				},
+				Description: "Days of the week the window opens on (e.g., 'saturday', 'sunday')",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(clockTimePattern, "must be a 24-hour time in HH:MM format, e.g. '02:30'"),
+				Description:  "Time of day the window opens, in 24-hour HH:MM format (e.g., '02:30')",
+			},
+			"duration": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateMaintenanceDuration,
+				DiffSuppressFunc: suppressEquivalentDuration,
+				Description:      "How long the window stays open, as a duration between 1h and 24h (e.g., '4h', '90m')",
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimezone,
+				Description:  "IANA time zone start_time is given in (e.g., 'Europe/Berlin') (default: UTC)",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the window is active. While disabled, automated maintenance may run at any time (default: true)",
+			},
+			"next_window_start": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the next window, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+// clockTimePattern matches a 24-hour HH:MM time of day.
+var clockTimePattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// validateMaintenanceDuration checks that duration is a Go duration between 1h and 24h.
+func validateMaintenanceDuration(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("%q must be a string", k)}
+	}
+	dur, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q: %v", k, err)}
+	}
+	if dur < time.Hour || dur > 24*time.Hour {
+		return nil, []error{fmt.Errorf("%q must be between 1h and 24h, got %s", k, s)}
+	}
+	return nil, nil
+}
+
+// suppressEquivalentDuration hides differences between durations such as "4h" and "4h0m0s".
+func suppressEquivalentDuration(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	n, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+// validateTimezone checks that timezone is a known IANA time zone.
+func validateTimezone(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("%q must be a string", k)}
+	}
+	if _, err := time.LoadLocation(s); err != nil {
+		return nil, []error{fmt.Errorf("%q: unknown time zone %q", k, s)}
+	}
+	return nil, nil
+}
+
+// buildMaintenanceWindowPayload converts Terraform state to API payload.
+func buildMaintenanceWindowPayload(d *schema.ResourceData) MaintenanceWindowPayload {
+	payload := MaintenanceWindowPayload{
+		ClusterName: d.Get("cluster_name").(string),
+		StartTime:   d.Get("start_time").(string),
+		Duration:    d.Get("duration").(string),
+		Timezone:    d.Get("timezone").(string),
+		Enabled:     d.Get("enabled").(bool),
+	}
+
+	if days, ok := d.Get("days").(*schema.Set); ok {
+		for _, day := range days.List() {
+			payload.Days = append(payload.Days, day.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceMaintenanceWindowCreate calls POST /maintenance/api/v1/windows.
+func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildMaintenanceWindowPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/maintenance/api/v1/windows", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create maintenance window failed: %s: %s", resp.Status, string(b))
+	}
+
+	var window MaintenanceWindowInfo
+	if err := decodeAPIResponse(resp, &window); err != nil {
+		return diag.Errorf("failed to decode create maintenance window response: %v", err)
+	}
+	if window.ID == "" {
+		return diag.Errorf("create maintenance window succeeded but no id returned")
+	}
+
+	d.SetId(window.ID)
+	log.Printf("[INFO] created maintenance window %s", window.ID)
+	return resourceMaintenanceWindowRead(ctx, d, m)
+}
+
+// resourceMaintenanceWindowRead calls GET /maintenance/api/v1/windows/:id.
+func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	window, err := fetchMaintenanceWindowByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if window == nil {
+		// Maintenance window not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", window.ClusterName)
+	_ = d.Set("days", window.Days)
+	_ = d.Set("start_time", window.StartTime)
+	_ = d.Set("duration", window.Duration)
+	_ = d.Set("timezone", window.Timezone)
+	_ = d.Set("enabled", window.Enabled)
+	_ = d.Set("next_window_start", window.NextWindowStart)
+
+	return nil
+}
+
+// resourceMaintenanceWindowUpdate calls PUT /maintenance/api/v1/windows/:id.
+func resourceMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildMaintenanceWindowPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/maintenance/api/v1/windows/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update maintenance window failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceMaintenanceWindowRead(ctx, d, m)
+}
+
+// resourceMaintenanceWindowDelete calls DELETE /maintenance/api/v1/windows/:id.
+func resourceMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/maintenance/api/v1/windows/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] maintenance window %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete maintenance window failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted maintenance window %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchMaintenanceWindowByID queries GET /maintenance/api/v1/windows/:id and returns the maintenance window.
+func fetchMaintenanceWindowByID(ctx context.Context, client *apiClient, id string) (*MaintenanceWindowInfo, error) {
+	u := fmt.Sprintf("%s/maintenance/api/v1/windows/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("maintenance window fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var window MaintenanceWindowInfo
+	if err := decodeAPIResponse(resp, &window); err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
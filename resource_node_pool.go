@@ -0,0 +1,445 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NodePoolTaint represents a Kubernetes taint applied to every node of a pool.
+type NodePoolTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// NodePoolAutoscaling represents the autoscaling bounds of a node pool.
+type NodePoolAutoscaling struct {
+	MinNodes int `json:"minNodes"`
+	MaxNodes int `json:"maxNodes"`
+}
+
+// NodePoolPayload represents the JSON body sent to create/update node pools.
+type NodePoolPayload struct {
+	ClusterName   string               `json:"clusterName"`
+	Name          string               `json:"name"`
+	InstanceClass string               `json:"instanceClass"`
+	NodeCount     int                  `json:"nodeCount,omitempty"`
+	Autoscaling   *NodePoolAutoscaling `json:"autoscaling,omitempty"`
+	Labels        map[string]string    `json:"labels,omitempty"`
+	Taints        []NodePoolTaint      `json:"taints"`
+}
+
+// NodePoolInfo represents the JSON structure returned from the node pools API.
+type NodePoolInfo struct {
+	ID            string               `json:"id"`
+	ClusterName   string               `json:"clusterName"`
+	Name          string               `json:"name"`
+	InstanceClass string               `json:"instanceClass"`
+	NodeCount     int                  `json:"nodeCount"`
+	Autoscaling   *NodePoolAutoscaling `json:"autoscaling,omitempty"`
+	Labels        map[string]string    `json:"labels"`
+	Taints        []NodePoolTaint      `json:"taints"`
+	Status        string               `json:"status"`
+}
+
+// resourceNodePool defines the bugx_node_pool resource schema and CRUD.
+// A node pool gives a cluster dedicated nodes; scaling and taint changes are applied in place.
+func resourceNodePool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNodePoolCreate,
+		ReadContext:   resourceNodePoolRead,
+		UpdateContext: resourceNodePoolUpdate,
+		DeleteContext: resourceNodePoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceNodePoolDiff,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the node pool is attached to",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the node pool, unique within the cluster",
+			},
+			"instance_class": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance class of the nodes (e.g., 'standard-4', 'gpu-a10')",
+			},
+			"node_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"autoscaling"},
+				Description:   "Fixed number of nodes. Computed from the autoscaler when autoscaling is set",
+			},
+			"autoscaling": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Let the platform scale the pool between min_nodes and max_nodes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_nodes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Minimum number of nodes",
+						},
+						"max_nodes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of nodes",
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kubernetes labels applied to every node, for use in node selectors",
+			},
+			"taint": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Kubernetes taints applied to every node",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Taint key",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Taint value",
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}, false),
+							Description:  "Taint effect: 'NoSchedule', 'PreferNoSchedule' or 'NoExecute'",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Node pool status reported by the API (e.g., 'ready', 'scaling')",
+			},
+		},
+	}
+}
+
+// buildNodePoolPayload converts Terraform state to API payload.
+func buildNodePoolPayload(d *schema.ResourceData) NodePoolPayload {
+	payload := NodePoolPayload{
+		ClusterName:   d.Get("cluster_name").(string),
+		Name:          d.Get("name").(string),
+		InstanceClass: d.Get("instance_class").(string),
+		Labels:        expandStringMap(d.Get("labels").(map[string]interface{})),
+		Taints:        []NodePoolTaint{},
+	}
+
+	if blocks := d.Get("autoscaling").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		a := blocks[0].(map[string]interface{})
+		payload.Autoscaling = &NodePoolAutoscaling{
+			MinNodes: a["min_nodes"].(int),
+			MaxNodes: a["max_nodes"].(int),
+		}
+	} else {
+		payload.NodeCount = d.Get("node_count").(int)
+	}
+
+	if taints, ok := d.Get("taint").(*schema.Set); ok {
+		for _, raw := range taints.List() {
+			t := raw.(map[string]interface{})
+			payload.Taints = append(payload.Taints, NodePoolTaint{
+				Key:    t["key"].(string),
+				Value:  t["value"].(string),
+				Effect: t["effect"].(string),
+			})
+		}
+	}
+
+	return payload
+}
+
+// flattenNodePoolAutoscaling converts API autoscaling bounds to Terraform state.
+func flattenNodePoolAutoscaling(a *NodePoolAutoscaling) []interface{} {
+	if a == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"min_nodes": a.MinNodes,
+		"max_nodes": a.MaxNodes,
+	}}
+}
+
+// flattenNodePoolTaints converts API taints to Terraform state.
+func flattenNodePoolTaints(taints []NodePoolTaint) []interface{} {
+	out := make([]interface{}, 0, len(taints))
+	for _, t := range taints {
+		out = append(out, map[string]interface{}{
+			"key":    t.Key,
+			"value":  t.Value,
+			"effect": t.Effect,
+		})
+	}
+	return out
+}
+
+// resourceNodePoolDiff requires either node_count or autoscaling, with ordered bounds.
+func resourceNodePoolDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	blocks := d.Get("autoscaling").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		if d.Id() == "" && d.NewValueKnown("node_count") && d.Get("node_count").(int) == 0 {
+			return fmt.Errorf("one of node_count or autoscaling must be set")
+		}
+		return nil
+	}
+	a := blocks[0].(map[string]interface{})
+	if minNodes, maxNodes := a["min_nodes"].(int), a["max_nodes"].(int); minNodes > maxNodes {
+		return fmt.Errorf("autoscaling.0.min_nodes (%d) must not be greater than max_nodes (%d)", minNodes, maxNodes)
+	}
+	// The autoscaler owns the node count; plan it as unknown instead of a diff.
+	if d.Id() != "" && d.HasChange("autoscaling") {
+		return d.SetNewComputed("node_count")
+	}
+	return nil
+}
+
+// resourceNodePoolCreate calls POST /nodes/api/v1/node-pools.
+func resourceNodePoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildNodePoolPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/nodes/api/v1/node-pools", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create node pool failed: %s: %s", resp.Status, string(b))
+	}
+
+	var pool NodePoolInfo
+	if err := decodeAPIResponse(resp, &pool); err != nil {
+		return diag.Errorf("failed to decode create node pool response: %v", err)
+	}
+	if pool.ID == "" {
+		return diag.Errorf("create node pool succeeded but no id returned")
+	}
+
+	d.SetId(pool.ID)
+	log.Printf("[INFO] created node pool %s", pool.ID)
+	return resourceNodePoolRead(ctx, d, m)
+}
+
+// resourceNodePoolRead calls GET /nodes/api/v1/node-pools/:id.
+func resourceNodePoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	pool, err := fetchNodePoolByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if pool == nil {
+		// Node pool not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", pool.ClusterName)
+	_ = d.Set("name", pool.Name)
+	_ = d.Set("instance_class", pool.InstanceClass)
+	_ = d.Set("node_count", pool.NodeCount)
+	_ = d.Set("autoscaling", flattenNodePoolAutoscaling(pool.Autoscaling))
+	_ = d.Set("labels", pool.Labels)
+	_ = d.Set("taint", flattenNodePoolTaints(pool.Taints))
+	_ = d.Set("status", pool.Status)
+
+	return nil
+}
+
+// resourceNodePoolUpdate calls PUT /nodes/api/v1/node-pools/:id.
+func resourceNodePoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildNodePoolPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/nodes/api/v1/node-pools/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update node pool failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceNodePoolRead(ctx, d, m)
+}
+
+// resourceNodePoolDelete calls DELETE /nodes/api/v1/node-pools/:id.
+func resourceNodePoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/nodes/api/v1/node-pools/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] node pool %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete node pool failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted node pool %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchNodePoolByID queries GET /nodes/api/v1/node-pools/:id and returns the node pool.
+func fetchNodePoolByID(ctx context.Context, client *apiClient, id string) (*NodePoolInfo, error) {
+	u := fmt.Sprintf("%s/nodes/api/v1/node-pools/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("node pool fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var pool NodePoolInfo
+	if err := decodeAPIResponse(resp, &pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
@@ -7,9 +7,14 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // resourceOrphanCleanup defines a resource that deletes orphaned applications
@@ -20,6 +25,7 @@ func resourceOrphanCleanup() *schema.Resource {
 		ReadContext:   resourceOrphanCleanupRead,
 		UpdateContext: resourceOrphanCleanupUpdate,
 		DeleteContext: resourceOrphanCleanupDelete,
+		CustomizeDiff: resourceOrphanCleanupDiff,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
@@ -38,7 +44,38 @@ func resourceOrphanCleanup() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "List of Helm release names to keep. If provided along with cluster namespace, apps matching '{namespace}-{release}' pattern that are NOT in this list will be deleted. Use this for automatic cleanup based on release names.",
+				Description: "List of Helm release names to keep. If provided, the cluster's apps are listed and every app matching '{namespace}-*' whose release is NOT in this list is deleted.",
+			},
+			"name_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression matched against app names. If set, only matching apps are treated as orphans, and the cluster's apps are listed even without keep_releases",
+			},
+			"exclude_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+				Description: "Regular expressions for apps that are never deleted, such as monitoring or ingress. Matched against the app name and its release, and applied to apps_to_delete too",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only compute candidate_apps without deleting anything (default: false)",
+			},
+			"candidate_apps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Application names the cleanup deletes, computed during plan. Apply deletes exactly this list",
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 20),
+				Description:  "Number of apps deleted concurrently (default: 4)",
 			},
 			"deleted_apps": {
 				Type:        schema.TypeList,
@@ -46,110 +83,269 @@ func resourceOrphanCleanup() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of application names that were successfully deleted",
 			},
+			"failed_apps": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Application names that could not be deleted, mapped to the error. Failed apps are retried on the next apply",
+			},
 		},
 	}
 }
 
-// resourceOrphanCleanupCreate deletes the specified orphaned applications
-func resourceOrphanCleanupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// resourceOrphanCleanupDiff computes candidate_apps during plan so the apps a cleanup
+// deletes can be reviewed before apply. A changed candidate list re-runs the cleanup.
+func resourceOrphanCleanupDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
 	client, ok := m.(*apiClient)
 	if !ok || client == nil {
-		return diag.Errorf("invalid API client configuration")
+		return nil
 	}
 
-	clusterName := d.Get("cluster_name").(string)
+	// Retry the apps the last run failed to delete.
+	if d.Id() != "" && len(d.Get("failed_apps").(map[string]interface{})) > 0 {
+		if err := d.SetNewComputed("failed_apps"); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range []string{"cluster_name", "apps_to_delete", "keep_releases", "name_pattern", "exclude_patterns"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("candidate_apps")
+		}
+	}
+
+	opts, err := expandOrphanCleanupOptions(d.Get)
+	if err != nil {
+		return err
+	}
+	candidates, err := orphanCleanupCandidates(ctx, client, opts)
+	if err != nil {
+		// The cluster may not exist yet; the candidates are computed again during apply.
+		log.Printf("[WARN] could not compute orphan cleanup candidates during plan: %v", err)
+		return d.SetNewComputed("candidate_apps")
+	}
+	return d.SetNew("candidate_apps", candidates)
+}
+
+// orphanCleanupOptions holds the arguments of bugx_orphan_cleanup that select the apps to delete.
+type orphanCleanupOptions struct {
+	ClusterName     string
+	Explicit        []string
+	Keep            map[string]bool
+	NamePattern     *regexp.Regexp
+	ExcludePatterns []*regexp.Regexp
+}
+
+// expandOrphanCleanupOptions reads the selection arguments through get, which is the Get
+// method of either the resource data or the plan-time diff.
+func expandOrphanCleanupOptions(get func(string) interface{}) (orphanCleanupOptions, error) {
+	opts := orphanCleanupOptions{
+		ClusterName: get("cluster_name").(string),
+		Keep:        make(map[string]bool),
+	}
+	for _, appInterface := range get("apps_to_delete").(*schema.Set).List() {
+		if appName := appInterface.(string); appName != "" {
+			opts.Explicit = append(opts.Explicit, appName)
+		}
+	}
+	sort.Strings(opts.Explicit)
+	for _, releaseInterface := range get("keep_releases").(*schema.Set).List() {
+		if release := releaseInterface.(string); release != "" {
+			opts.Keep[release] = true
+		}
+	}
+	if expr := get("name_pattern").(string); expr != "" {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return opts, fmt.Errorf("invalid name_pattern: %w", err)
+		}
+		opts.NamePattern = re
+	}
+	for _, exprInterface := range get("exclude_patterns").([]interface{}) {
+		re, err := regexp.Compile(exprInterface.(string))
+		if err != nil {
+			return opts, fmt.Errorf("invalid exclude pattern %q: %w", exprInterface.(string), err)
+		}
+		opts.ExcludePatterns = append(opts.ExcludePatterns, re)
+	}
+	return opts, nil
+}
+
+// excluded reports whether an app name or its release matches one of the exclude patterns.
+func (o orphanCleanupOptions) excluded(appName, release string) bool {
+	for _, re := range o.ExcludePatterns {
+		if re.MatchString(appName) || (release != "" && re.MatchString(release)) {
+			return true
+		}
+	}
+	return false
+}
+
+// orphanCleanupCandidates returns the explicit apps to delete followed by the orphans
+// found from keep and name_pattern, without duplicates and without excluded apps.
+func orphanCleanupCandidates(ctx context.Context, client *apiClient, opts orphanCleanupOptions) ([]string, error) {
+	clusterName := opts.ClusterName
 
 	// Get cluster info to verify cluster exists and get namespace
 	clusterInfo, err := fetchClusterInfo(ctx, client, clusterName)
 	if err != nil {
-		return diag.Errorf("failed to fetch cluster info for %s: %v", clusterName, err)
+		return nil, fmt.Errorf("failed to fetch cluster info for %s: %v", clusterName, err)
 	}
 	if clusterInfo == nil {
-		return diag.Errorf("cluster %s not found", clusterName)
+		return nil, fmt.Errorf("cluster %s not found", clusterName)
 	}
-
 	clusterNamespace := clusterInfo.NameSpace
-	log.Printf("[INFO] Starting orphan cleanup for cluster %s (namespace: %s)", clusterName, clusterNamespace)
 
-	var appsToDelete []string
+	candidates := []string{}
+	seen := make(map[string]bool)
+	add := func(appName, release string) {
+		if seen[appName] {
+			return
+		}
+		seen[appName] = true
+		if opts.excluded(appName, release) {
+			log.Printf("[INFO] Not deleting app %s: it matches exclude_patterns", appName)
+			return
+		}
+		candidates = append(candidates, appName)
+	}
 
 	// Method 1: Explicit apps_to_delete list
-	if appsToDeleteSet, ok := d.GetOk("apps_to_delete"); ok {
-		for _, appInterface := range appsToDeleteSet.(*schema.Set).List() {
-			appName := appInterface.(string)
-			if appName != "" {
-				appsToDelete = append(appsToDelete, appName)
-			}
-		}
-		log.Printf("[INFO] Found %d apps to delete from explicit list", len(appsToDelete))
-	}
-
-	// Method 2: Use keep_releases to determine what to delete
-	// This is a best-effort approach: we'll try to delete apps that match the pattern
-	// but aren't in the keep list. Since we can't list all apps, this requires
-	// the user to know which releases might exist.
-	if keepReleasesSet, ok := d.GetOk("keep_releases"); ok && clusterNamespace != "" {
-		keepReleases := make(map[string]bool)
-		for _, releaseInterface := range keepReleasesSet.(*schema.Set).List() {
-			release := releaseInterface.(string)
-			if release != "" {
-				keepReleases[release] = true
-				// The app name would be {namespace}-{release}
-				keepReleases[clusterNamespace+"-"+release] = true
-			}
+	for _, appName := range opts.Explicit {
+		add(appName, strings.TrimPrefix(appName, clusterNamespace+"-"))
+	}
+
+	// Method 2: Use keep_releases and name_pattern to determine what to delete
+	// Every app of the cluster matching '{namespace}-*' and name_pattern whose release is not kept is an orphan.
+	if (len(opts.Keep) > 0 || opts.NamePattern != nil) && clusterNamespace != "" {
+		apps, err := fetchApps(ctx, client, clusterName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list apps of cluster %s: %v", clusterName, err)
 		}
+		orphans := findOrphanApps(apps, clusterNamespace, opts.Keep, opts.NamePattern)
+		log.Printf("[INFO] Keeping %d releases; found %d orphaned apps matching %s-* out of %d apps", len(opts.Keep), len(orphans), clusterNamespace, len(apps))
 
-		// If user provided specific releases to keep, we can infer apps to delete
-		// by checking common release names. But without a list API, we can't know
-		// all apps. So this method is mainly for when user knows what might exist.
-		log.Printf("[INFO] Keeping %d releases (apps matching pattern %s-*)", len(keepReleases), clusterNamespace)
-		// Note: Without a list API, we can't automatically find all orphaned apps
-		// The user should use apps_to_delete for explicit cleanup
+		for _, app := range orphans {
+			add(app.Name, app.Release)
+		}
 	}
 
-	if len(appsToDelete) == 0 {
-		log.Printf("[WARN] No apps specified for deletion. Provide either 'apps_to_delete' or use 'keep_releases' with known release names.")
-		d.SetId(fmt.Sprintf("%s-orphan-cleanup", clusterName))
-		d.Set("deleted_apps", []string{})
-		return resourceOrphanCleanupRead(ctx, d, m)
+	return candidates, nil
+}
+
+// resourceOrphanCleanupCreate deletes the orphaned applications shown in the plan
+func resourceOrphanCleanupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
 	}
 
-	var deletedApps []string
-	var errors []error
+	clusterName := d.Get("cluster_name").(string)
+	log.Printf("[INFO] Starting orphan cleanup for cluster %s", clusterName)
 
-	// Delete each app
-	for _, appName := range appsToDelete {
-		err := deleteOrphanApp(ctx, client, clusterName, appName)
+	// Delete exactly what the plan showed; only compute the candidates now if the plan could not.
+	var appsToDelete []string
+	if d.GetRawPlan().GetAttr("candidate_apps").IsKnown() {
+		for _, appInterface := range d.Get("candidate_apps").([]interface{}) {
+			appsToDelete = append(appsToDelete, appInterface.(string))
+		}
+	} else {
+		opts, err := expandOrphanCleanupOptions(d.Get)
 		if err != nil {
-			log.Printf("[ERROR] Failed to delete app %s: %v", appName, err)
-			errors = append(errors, fmt.Errorf("failed to delete app %s: %w", appName, err))
-		} else {
-			deletedApps = append(deletedApps, appName)
-			log.Printf("[INFO] Successfully deleted app %s", appName)
+			return diag.FromErr(err)
+		}
+		candidates, err := orphanCleanupCandidates(ctx, client, opts)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		appsToDelete = candidates
 	}
 
-	// Set ID
 	d.SetId(fmt.Sprintf("%s-orphan-cleanup", clusterName))
+	_ = d.Set("candidate_apps", appsToDelete)
+
+	if d.Get("dry_run").(bool) {
+		log.Printf("[INFO] Dry run: would delete %d apps from cluster %s: %v", len(appsToDelete), clusterName, appsToDelete)
+		d.Set("deleted_apps", []string{})
+		d.Set("failed_apps", map[string]string{})
+		return resourceOrphanCleanupRead(ctx, d, m)
+	}
+
+	if len(appsToDelete) == 0 {
+		log.Printf("[INFO] No apps to delete for cluster %s", clusterName)
+		d.Set("deleted_apps", []string{})
+		d.Set("failed_apps", map[string]string{})
+		return resourceOrphanCleanupRead(ctx, d, m)
+	}
 
-	// Store deleted apps
+	deletedApps, failedApps := deleteInParallel(ctx, appsToDelete, d.Get("parallelism").(int), func(ctx context.Context, appName string) error {
+		return deleteOrphanApp(ctx, client, clusterName, appName)
+	})
+
+	// Store per-app results; one failure does not hide the apps that were deleted.
 	if err := d.Set("deleted_apps", deletedApps); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("failed_apps", failedApps); err != nil {
+		return diag.FromErr(err)
+	}
 
-	// If there were errors, return them
-	if len(errors) > 0 {
-		var diags diag.Diagnostics
-		for _, err := range errors {
-			diags = append(diags, diag.FromErr(err)...)
+	if len(failedApps) > 0 {
+		failed := make([]string, 0, len(failedApps))
+		for appName, msg := range failedApps {
+			failed = append(failed, fmt.Sprintf("%s: %s", appName, msg))
 		}
-		return diags
+		sort.Strings(failed)
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to delete %d of %d apps from cluster %s", len(failedApps), len(appsToDelete), clusterName),
+			Detail:   strings.Join(failed, "\n"),
+		}}
 	}
 
 	log.Printf("[INFO] Orphan cleanup completed for cluster %s: deleted %d apps", clusterName, len(deletedApps))
 	return resourceOrphanCleanupRead(ctx, d, m)
 }
 
+// deleteInParallel calls del for each name with at most parallelism concurrent calls. It returns
+// the deleted names in input order and the error of each name that could not be deleted.
+func deleteInParallel(ctx context.Context, names []string, parallelism int, del func(context.Context, string) error) ([]string, map[string]string) {
+	errs := make([]error, len(names))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return
+			}
+			errs[i] = del(ctx, name)
+			if errs[i] != nil {
+				log.Printf("[ERROR] Failed to delete %s: %v", name, errs[i])
+			} else {
+				log.Printf("[INFO] Successfully deleted %s", name)
+			}
+		}(i, name)
+	}
+	wg.Wait()
+
+	deleted := []string{}
+	failed := make(map[string]string)
+	for i, name := range names {
+		if errs[i] != nil {
+			failed[name] = errs[i].Error()
+		} else {
+			deleted = append(deleted, name)
+		}
+	}
+	return deleted, failed
+}
+
 // resourceOrphanCleanupRead reads the current state of orphan cleanup
 func resourceOrphanCleanupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// This resource doesn't have a server-side state to read
@@ -157,9 +353,9 @@ func resourceOrphanCleanupRead(ctx context.Context, d *schema.ResourceData, m in
 	return nil
 }
 
-// resourceOrphanCleanupUpdate handles updates - if apps_to_delete or keep_releases changes, re-run cleanup
+// resourceOrphanCleanupUpdate handles updates - if the inputs, dry_run, the candidate apps or earlier failures change, re-run cleanup
 func resourceOrphanCleanupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChange("apps_to_delete") || d.HasChange("keep_releases") {
+	if d.HasChanges("apps_to_delete", "keep_releases", "name_pattern", "exclude_patterns", "dry_run", "candidate_apps", "failed_apps") {
 		// Re-run cleanup with new apps list
 		return resourceOrphanCleanupCreate(ctx, d, m)
 	}
@@ -174,6 +370,27 @@ func resourceOrphanCleanupDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// findOrphanApps returns the apps matching '{namespace}-*' and, if set, namePattern whose release
+// is not in keep. An app is kept when either its release or the release part of its name is listed.
+func findOrphanApps(apps []AppInfo, namespace string, keep map[string]bool, namePattern *regexp.Regexp) []AppInfo {
+	prefix := namespace + "-"
+	var orphans []AppInfo
+	for _, app := range apps {
+		if !strings.HasPrefix(app.Name, prefix) {
+			continue
+		}
+		if namePattern != nil && !namePattern.MatchString(app.Name) {
+			continue
+		}
+		if keep[strings.TrimPrefix(app.Name, prefix)] || (app.Release != "" && keep[app.Release]) {
+			continue
+		}
+		orphans = append(orphans, app)
+	}
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i].Name < orphans[j].Name })
+	return orphans
+}
+
 // deleteOrphanApp deletes an application using the deleteapp API
 func deleteOrphanApp(ctx context.Context, client *apiClient, clusterName string, appName string) error {
 	deleteURL := fmt.Sprintf("%s/deleteapp?Name=%s", client.BaseURL, url.QueryEscape(appName))
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// PodDisruptionBudgetPayload represents the JSON body sent to create/update pod disruption budgets.
+// MinAvailable and MaxUnavailable are either a number or a percentage, e.g. "2" or "50%".
+type PodDisruptionBudgetPayload struct {
+	ClusterName    string            `json:"clusterName"`
+	Namespace      string            `json:"namespace"`
+	Name           string            `json:"name"`
+	MatchLabels    map[string]string `json:"matchLabels"`
+	MinAvailable   string            `json:"minAvailable,omitempty"`
+	MaxUnavailable string            `json:"maxUnavailable,omitempty"`
+}
+
+// PodDisruptionBudgetInfo represents the JSON structure returned from the pod disruption budgets API.
+type PodDisruptionBudgetInfo struct {
+	ID                 string            `json:"id"`
+	ClusterName        string            `json:"clusterName"`
+	Namespace          string            `json:"namespace"`
+	Name               string            `json:"name"`
+	MatchLabels        map[string]string `json:"matchLabels"`
+	MinAvailable       string            `json:"minAvailable"`
+	MaxUnavailable     string            `json:"maxUnavailable"`
+	CurrentHealthy     int               `json:"currentHealthy"`
+	DesiredHealthy     int               `json:"desiredHealthy"`
+	DisruptionsAllowed int               `json:"disruptionsAllowed"`
+}
+
+// resourcePodDisruptionBudget defines the bugx_pod_disruption_budget resource schema and CRUD.
+// A pod disruption budget limits how many matching pods voluntary disruptions, such as node
+// drains during maintenance, may take down at once.
+func resourcePodDisruptionBudget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePodDisruptionBudgetCreate,
+		ReadContext:   resourcePodDisruptionBudgetRead,
+		UpdateContext: resourcePodDisruptionBudgetUpdate,
+		DeleteContext: resourcePodDisruptionBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the pods run in",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace of the pods (default: default)",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the pod disruption budget",
+			},
+			"match_labels": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels selecting the pods the budget protects",
+			},
+			"min_available": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"min_available", "max_unavailable"},
+				ValidateFunc: validation.StringMatch(intOrPercentPattern, "must be a number or a percentage, e.g. '2' or '50%'"),
+				Description:  "Number or percentage of matching pods that must stay available (e.g., '2' or '50%')",
+			},
+			"max_unavailable": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"min_available", "max_unavailable"},
+				ValidateFunc: validation.StringMatch(intOrPercentPattern, "must be a number or a percentage, e.g. '1' or '25%'"),
+				Description:  "Number or percentage of matching pods that may be unavailable at once (e.g., '1' or '25%')",
+			},
+			"current_healthy": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of matching pods that are currently healthy",
+			},
+			"desired_healthy": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum number of healthy pods the budget requires",
+			},
+			"disruptions_allowed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of pod disruptions currently allowed",
+			},
+		},
+	}
+}
+
+// intOrPercentPattern matches a Kubernetes IntOrString given as a number or a percentage.
+var intOrPercentPattern = regexp.MustCompile(`^[0-9]+%?$`)
+
+// buildPodDisruptionBudgetPayload converts Terraform state to API payload.
+func buildPodDisruptionBudgetPayload(d *schema.ResourceData) PodDisruptionBudgetPayload {
+	return PodDisruptionBudgetPayload{
+		ClusterName:    d.Get("cluster_name").(string),
+		Namespace:      d.Get("namespace").(string),
+		Name:           d.Get("name").(string),
+		MatchLabels:    expandStringMap(d.Get("match_labels").(map[string]interface{})),
+		MinAvailable:   d.Get("min_available").(string),
+		MaxUnavailable: d.Get("max_unavailable").(string),
+	}
+}
+
+// resourcePodDisruptionBudgetCreate calls POST /policy/api/v1/pod-disruption-budgets.
+func resourcePodDisruptionBudgetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildPodDisruptionBudgetPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/policy/api/v1/pod-disruption-budgets", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create pod disruption budget failed: %s: %s", resp.Status, string(b))
+	}
+
+	var pdb PodDisruptionBudgetInfo
+	if err := decodeAPIResponse(resp, &pdb); err != nil {
+		return diag.Errorf("failed to decode create pod disruption budget response: %v", err)
+	}
+	if pdb.ID == "" {
+		return diag.Errorf("create pod disruption budget succeeded but no id returned")
+	}
+
+	d.SetId(pdb.ID)
+	log.Printf("[INFO] created pod disruption budget %s", pdb.ID)
+	return resourcePodDisruptionBudgetRead(ctx, d, m)
+}
+
+// resourcePodDisruptionBudgetRead calls GET /policy/api/v1/pod-disruption-budgets/:id.
+func resourcePodDisruptionBudgetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	pdb, err := fetchPodDisruptionBudgetByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if pdb == nil {
+		// Pod disruption budget not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", pdb.ClusterName)
+	_ = d.Set("namespace", pdb.Namespace)
+	_ = d.Set("name", pdb.Name)
+	_ = d.Set("match_labels", pdb.MatchLabels)
+	_ = d.Set("min_available", pdb.MinAvailable)
+	_ = d.Set("max_unavailable", pdb.MaxUnavailable)
+	_ = d.Set("current_healthy", pdb.CurrentHealthy)
+	_ = d.Set("desired_healthy", pdb.DesiredHealthy)
+	_ = d.Set("disruptions_allowed", pdb.DisruptionsAllowed)
+
+	return nil
+}
+
+// resourcePodDisruptionBudgetUpdate calls PUT /policy/api/v1/pod-disruption-budgets/:id.
+func resourcePodDisruptionBudgetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildPodDisruptionBudgetPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/policy/api/v1/pod-disruption-budgets/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update pod disruption budget failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourcePodDisruptionBudgetRead(ctx, d, m)
+}
+
+// resourcePodDisruptionBudgetDelete calls DELETE /policy/api/v1/pod-disruption-budgets/:id.
+func resourcePodDisruptionBudgetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/policy/api/v1/pod-disruption-budgets/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] pod disruption budget %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete pod disruption budget failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted pod disruption budget %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchPodDisruptionBudgetByID queries GET /policy/api/v1/pod-disruption-budgets/:id and returns the pod disruption budget.
+func fetchPodDisruptionBudgetByID(ctx context.Context, client *apiClient, id string) (*PodDisruptionBudgetInfo, error) {
+	u := fmt.Sprintf("%s/policy/api/v1/pod-disruption-budgets/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("pod disruption budget fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var pdb PodDisruptionBudgetInfo
+	if err := decodeAPIResponse(resp, &pdb); err != nil {
+		return nil, err
+	}
+	return &pdb, nil
+}
@@ -0,0 +1,401 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// QuotaLimits represents the hard limits of a quota. Empty quantities and zero
+// counts are unlimited.
+type QuotaLimits struct {
+	CPU                    string `json:"cpu,omitempty"`
+	Memory                 string `json:"memory,omitempty"`
+	Storage                string `json:"storage,omitempty"`
+	Pods                   int    `json:"pods,omitempty"`
+	Services               int    `json:"services,omitempty"`
+	PersistentVolumeClaims int    `json:"persistentVolumeClaims,omitempty"`
+	ConfigMaps             int    `json:"configMaps,omitempty"`
+	Secrets                int    `json:"secrets,omitempty"`
+}
+
+// QuotaPayload represents the JSON body sent to create/update quotas.
+type QuotaPayload struct {
+	ClusterName string      `json:"clusterName"`
+	Namespace   string      `json:"namespace,omitempty"`
+	Hard        QuotaLimits `json:"hard"`
+}
+
+// QuotaInfo represents the JSON structure returned from the quotas API.
+type QuotaInfo struct {
+	ID          string            `json:"id"`
+	ClusterName string            `json:"clusterName"`
+	Namespace   string            `json:"namespace"`
+	Hard        QuotaLimits       `json:"hard"`
+	Used        map[string]string `json:"used"`
+}
+
+// resourceQuota defines the bugx_quota resource schema and CRUD.
+// A quota caps the compute, storage and object counts of a cluster, or of one namespace inside it.
+func resourceQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceQuotaCreate,
+		ReadContext:   resourceQuotaRead,
+		UpdateContext: resourceQuotaUpdate,
+		DeleteContext: resourceQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the quota applies to",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace inside the cluster the quota applies to. If empty, the quota covers the whole cluster",
+			},
+			"cpu": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity, e.g. '4' or '500m'"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Total CPU requests allowed (e.g., '4' or '500m')",
+			},
+			"memory": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity, e.g. '8Gi'"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Total memory requests allowed (e.g., '8Gi')",
+			},
+			"storage": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity, e.g. '100Gi'"),
+				DiffSuppressFunc: suppressEquivalentQuantity,
+				Description:      "Total persistent volume storage requests allowed (e.g., '100Gi')",
+			},
+			"pods": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of pods. 0 means unlimited",
+			},
+			"services": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of services. 0 means unlimited",
+			},
+			"persistent_volume_claims": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of persistent volume claims. 0 means unlimited",
+			},
+			"config_maps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of config maps. 0 means unlimited",
+			},
+			"secrets": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of Kubernetes secrets. 0 means unlimited",
+			},
+			"used": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Current usage reported by the API, keyed like the limits (e.g., 'cpu', 'pods')",
+			},
+		},
+	}
+}
+
+// resourceQuantityPattern matches a Kubernetes resource quantity such as 500m, 2 or 8Gi.
+var resourceQuantityPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|M|G|T|P|E|Ki|Mi|Gi|Ti|Pi|Ei)?$`)
+
+// suppressEquivalentQuantity hides differences between quantities the API
+// normalizes, such as "1" and "1000m" or "1024Mi" and "1Gi".
+func suppressEquivalentQuantity(k, old, new string, d *schema.ResourceData) bool {
+	o, okOld := parseQuantity(old)
+	n, okNew := parseQuantity(new)
+	return okOld && okNew && o == n
+}
+
+// parseQuantity converts a Kubernetes quantity to its value in base units.
+func parseQuantity(s string) (float64, bool) {
+	if !resourceQuantityPattern.MatchString(s) {
+		return 0, false
+	}
+	suffixes := []struct {
+		suffix     string
+		multiplier float64
+	}{
+		{"Ki", 1 << 10}, {"Mi", 1 << 20}, {"Gi", 1 << 30}, {"Ti", 1 << 40}, {"Pi", 1 << 50}, {"Ei", 1 << 60},
+		{"m", 1e-3}, {"k", 1e3}, {"M", 1e6}, {"G", 1e9}, {"T", 1e12}, {"P", 1e15}, {"E", 1e18},
+	}
+	multiplier := 1.0
+	for _, sfx := range suffixes {
+		if strings.HasSuffix(s, sfx.suffix) {
+			s = strings.TrimSuffix(s, sfx.suffix)
+			multiplier = sfx.multiplier
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v * multiplier, true
+}
+
+// buildQuotaPayload converts Terraform state to API payload.
+func buildQuotaPayload(d *schema.ResourceData) QuotaPayload {
+	return QuotaPayload{
+		ClusterName: d.Get("cluster_name").(string),
+		Namespace:   d.Get("namespace").(string),
+		Hard: QuotaLimits{
+			CPU:                    d.Get("cpu").(string),
+			Memory:                 d.Get("memory").(string),
+			Storage:                d.Get("storage").(string),
+			Pods:                   d.Get("pods").(int),
+			Services:               d.Get("services").(int),
+			PersistentVolumeClaims: d.Get("persistent_volume_claims").(int),
+			ConfigMaps:             d.Get("config_maps").(int),
+			Secrets:                d.Get("secrets").(int),
+		},
+	}
+}
+
+// resourceQuotaCreate calls POST /quota/api/v1/quotas.
+func resourceQuotaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildQuotaPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/quota/api/v1/quotas", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create quota failed: %s: %s", resp.Status, string(b))
+	}
+
+	var quota QuotaInfo
+	if err := decodeAPIResponse(resp, &quota); err != nil {
+		return diag.Errorf("failed to decode create quota response: %v", err)
+	}
+	if quota.ID == "" {
+		return diag.Errorf("create quota succeeded but no id returned")
+	}
+
+	d.SetId(quota.ID)
+	log.Printf("[INFO] created quota %s", quota.ID)
+	return resourceQuotaRead(ctx, d, m)
+}
+
+// resourceQuotaRead calls GET /quota/api/v1/quotas/:id.
+func resourceQuotaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	quota, err := fetchQuotaByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if quota == nil {
+		// Quota not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", quota.ClusterName)
+	_ = d.Set("namespace", quota.Namespace)
+	_ = d.Set("cpu", quota.Hard.CPU)
+	_ = d.Set("memory", quota.Hard.Memory)
+	_ = d.Set("storage", quota.Hard.Storage)
+	_ = d.Set("pods", quota.Hard.Pods)
+	_ = d.Set("services", quota.Hard.Services)
+	_ = d.Set("persistent_volume_claims", quota.Hard.PersistentVolumeClaims)
+	_ = d.Set("config_maps", quota.Hard.ConfigMaps)
+	_ = d.Set("secrets", quota.Hard.Secrets)
+	_ = d.Set("used", quota.Used)
+
+	return nil
+}
+
+// resourceQuotaUpdate calls PUT /quota/api/v1/quotas/:id.
+func resourceQuotaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildQuotaPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/quota/api/v1/quotas/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update quota failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceQuotaRead(ctx, d, m)
+}
+
+// resourceQuotaDelete calls DELETE /quota/api/v1/quotas/:id.
+func resourceQuotaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/quota/api/v1/quotas/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] quota %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete quota failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted quota %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchQuotaByID queries GET /quota/api/v1/quotas/:id and returns the quota.
+func fetchQuotaByID(ctx context.Context, client *apiClient, id string) (*QuotaInfo, error) {
+	u := fmt.Sprintf("%s/quota/api/v1/quotas/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("quota fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var quota QuotaInfo
+	if err := decodeAPIResponse(resp, &quota); err != nil {
+		return nil, err
+	}
+	return &quota, nil
+}
@@ -0,0 +1,375 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ReportSchedulePayload represents the JSON body sent to create/update report schedules.
+type ReportSchedulePayload struct {
+	Name            string   `json:"name"`
+	ReportType      string   `json:"reportType"`
+	Schedule        string   `json:"schedule"`
+	Format          string   `json:"format"`
+	Team            string   `json:"team,omitempty"`
+	ClusterNames    []string `json:"clusterNames,omitempty"`
+	EmailRecipients []string `json:"emailRecipients,omitempty"`
+	WebhookURL      string   `json:"webhookUrl,omitempty"`
+	Enabled         bool     `json:"enabled"`
+}
+
+// ReportScheduleInfo represents the JSON structure returned from the report schedules API.
+type ReportScheduleInfo struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	ReportType      string   `json:"reportType"`
+	Schedule        string   `json:"schedule"`
+	Format          string   `json:"format"`
+	Team            string   `json:"team,omitempty"`
+	ClusterNames    []string `json:"clusterNames,omitempty"`
+	EmailRecipients []string `json:"emailRecipients,omitempty"`
+	WebhookURL      string   `json:"webhookUrl,omitempty"`
+	Enabled         bool     `json:"enabled"`
+	NextRunAt       string   `json:"nextRunAt,omitempty"`
+	LastRunAt       string   `json:"lastRunAt,omitempty"`
+	LastRunStatus   string   `json:"lastRunStatus,omitempty"`
+}
+
+// resourceReportSchedule defines the bugx_report_schedule resource schema and CRUD.
+func resourceReportSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceReportScheduleCreate,
+		ReadContext:   resourceReportScheduleRead,
+		UpdateContext: resourceReportScheduleUpdate,
+		DeleteContext: resourceReportScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the report schedule",
+			},
+			"report_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"utilization", "cost", "compliance"}, false),
+				Description:  "Report to generate: 'utilization', 'cost' or 'compliance'",
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Cron expression for when the report is generated (e.g., '0 8 * * 1' for Mondays at 08:00)",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "pdf",
+				ValidateFunc: validation.StringInSlice([]string{"pdf", "csv", "html"}, false),
+				Description:  "Report format: 'pdf', 'csv' or 'html' (default: 'pdf')",
+			},
+			"team": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"team", "cluster_names"},
+				Description:  "Team whose clusters the report covers. Conflicts with cluster_names",
+			},
+			"cluster_names": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"team", "cluster_names"},
+				Description:  "Clusters the report covers. Conflicts with team",
+			},
+			"email_recipients": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringMatch(emailPattern, "must be an email address")},
+				AtLeastOneOf: []string{"email_recipients", "webhook_url"},
+				Description:  "Email addresses the report is sent to",
+			},
+			"webhook_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				AtLeastOneOf: []string{"email_recipients", "webhook_url"},
+				Description:  "HTTPS endpoint the report is posted to",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the schedule is active (default: true)",
+			},
+			"next_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the next scheduled run",
+			},
+			"last_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the latest run",
+			},
+			"last_run_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Outcome of the latest run (e.g., 'delivered', 'failed')",
+			},
+		},
+	}
+}
+
+// emailPattern is a deliberately loose check for email recipients; the API validates deliverability.
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// buildReportSchedulePayload converts Terraform state to API payload.
+func buildReportSchedulePayload(d *schema.ResourceData) ReportSchedulePayload {
+	payload := ReportSchedulePayload{
+		Name:       d.Get("name").(string),
+		ReportType: d.Get("report_type").(string),
+		Schedule:   d.Get("schedule").(string),
+		Format:     d.Get("format").(string),
+		Team:       d.Get("team").(string),
+		WebhookURL: d.Get("webhook_url").(string),
+		Enabled:    d.Get("enabled").(bool),
+	}
+
+	if clusters, ok := d.Get("cluster_names").(*schema.Set); ok {
+		for _, c := range clusters.List() {
+			payload.ClusterNames = append(payload.ClusterNames, c.(string))
+		}
+	}
+	if recipients, ok := d.Get("email_recipients").(*schema.Set); ok {
+		for _, r := range recipients.List() {
+			payload.EmailRecipients = append(payload.EmailRecipients, r.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceReportScheduleCreate calls POST /reports/api/v1/schedules.
+func resourceReportScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildReportSchedulePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/reports/api/v1/schedules", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create report schedule failed: %s: %s", resp.Status, string(b))
+	}
+
+	var schedule ReportScheduleInfo
+	if err := decodeAPIResponse(resp, &schedule); err != nil {
+		return diag.Errorf("failed to decode create report schedule response: %v", err)
+	}
+	if schedule.ID == "" {
+		return diag.Errorf("create report schedule succeeded but no id returned")
+	}
+
+	d.SetId(schedule.ID)
+	log.Printf("[INFO] created report schedule %s", schedule.ID)
+	return resourceReportScheduleRead(ctx, d, m)
+}
+
+// resourceReportScheduleRead calls GET /reports/api/v1/schedules/:id.
+func resourceReportScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	schedule, err := fetchReportScheduleByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if schedule == nil {
+		// Report schedule not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", schedule.Name)
+	_ = d.Set("report_type", schedule.ReportType)
+	_ = d.Set("schedule", schedule.Schedule)
+	_ = d.Set("format", schedule.Format)
+	_ = d.Set("team", schedule.Team)
+	_ = d.Set("cluster_names", schedule.ClusterNames)
+	_ = d.Set("email_recipients", schedule.EmailRecipients)
+	_ = d.Set("webhook_url", schedule.WebhookURL)
+	_ = d.Set("enabled", schedule.Enabled)
+	_ = d.Set("next_run_at", schedule.NextRunAt)
+	_ = d.Set("last_run_at", schedule.LastRunAt)
+	_ = d.Set("last_run_status", schedule.LastRunStatus)
+
+	return nil
+}
+
+// resourceReportScheduleUpdate calls PUT /reports/api/v1/schedules/:id.
+func resourceReportScheduleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildReportSchedulePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/reports/api/v1/schedules/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update report schedule failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceReportScheduleRead(ctx, d, m)
+}
+
+// resourceReportScheduleDelete calls DELETE /reports/api/v1/schedules/:id.
+func resourceReportScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/reports/api/v1/schedules/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] report schedule %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete report schedule failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted report schedule %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchReportScheduleByID queries GET /reports/api/v1/schedules/:id and returns the report schedule.
+func fetchReportScheduleByID(ctx context.Context, client *apiClient, id string) (*ReportScheduleInfo, error) {
+	u := fmt.Sprintf("%s/reports/api/v1/schedules/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("report schedule fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var schedule ReportScheduleInfo
+	if err := decodeAPIResponse(resp, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// RoleBindingPayload represents the JSON body sent to create/update role bindings.
+type RoleBindingPayload struct {
+	SubjectKind string `json:"subjectKind"`
+	SubjectName string `json:"subjectName"`
+	Role        string `json:"role"`
+	ClusterName string `json:"clusterName"`
+	Namespace   string `json:"namespace,omitempty"`
+}
+
+// RoleBindingInfo represents the JSON structure returned from the role bindings API.
+type RoleBindingInfo struct {
+	ID          string `json:"id"`
+	SubjectKind string `json:"subjectKind"`
+	SubjectName string `json:"subjectName"`
+	Role        string `json:"role"`
+	ClusterName string `json:"clusterName"`
+	Namespace   string `json:"namespace"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+// resourceRoleBinding defines the bugx_role_binding resource schema and CRUD.
+// A role binding grants a user or team a role on a cluster, or on one namespace inside it.
+func resourceRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleBindingCreate,
+		ReadContext:   resourceRoleBindingRead,
+		UpdateContext: resourceRoleBindingUpdate,
+		DeleteContext: resourceRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subject_kind": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"user", "team"}, false),
+				Description:  "Kind of subject the role is granted to: 'user' or 'team'",
+			},
+			"subject_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Username or team name the role is granted to",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "edit", "view"}, false),
+				Description:  "Role granted: 'admin', 'edit' or 'view'",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the role applies to",
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Namespace the role is limited to. If empty, the role applies to the whole cluster",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the role binding was created",
+			},
+		},
+	}
+}
+
+// buildRoleBindingPayload converts Terraform state to API payload.
+func buildRoleBindingPayload(d *schema.ResourceData) RoleBindingPayload {
+	return RoleBindingPayload{
+		SubjectKind: d.Get("subject_kind").(string),
+		SubjectName: d.Get("subject_name").(string),
+		Role:        d.Get("role").(string),
+		ClusterName: d.Get("cluster_name").(string),
+		Namespace:   d.Get("namespace").(string),
+	}
+}
+
+// resourceRoleBindingCreate calls POST /access/api/v1/role-bindings.
+func resourceRoleBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildRoleBindingPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/access/api/v1/role-bindings", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create role binding failed: %s: %s", resp.Status, string(b))
+	}
+
+	var rb RoleBindingInfo
+	if err := decodeAPIResponse(resp, &rb); err != nil {
+		return diag.Errorf("failed to decode create role binding response: %v", err)
+	}
+	if rb.ID == "" {
+		return diag.Errorf("create role binding succeeded but no id returned")
+	}
+
+	d.SetId(rb.ID)
+	log.Printf("[INFO] created role binding %s", rb.ID)
+	return resourceRoleBindingRead(ctx, d, m)
+}
+
+// resourceRoleBindingRead calls GET /access/api/v1/role-bindings/:id.
+func resourceRoleBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	rb, err := fetchRoleBindingByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if rb == nil {
+		// Role binding not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("subject_kind", rb.SubjectKind)
+	_ = d.Set("subject_name", rb.SubjectName)
+	_ = d.Set("role", rb.Role)
+	_ = d.Set("cluster_name", rb.ClusterName)
+	_ = d.Set("namespace", rb.Namespace)
+	_ = d.Set("created_at", rb.CreatedAt)
+
+	return nil
+}
+
+// resourceRoleBindingUpdate calls PUT /access/api/v1/role-bindings/:id.
+func resourceRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildRoleBindingPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/access/api/v1/role-bindings/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update role binding failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceRoleBindingRead(ctx, d, m)
+}
+
+// resourceRoleBindingDelete calls DELETE /access/api/v1/role-bindings/:id.
+func resourceRoleBindingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/access/api/v1/role-bindings/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] role binding %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete role binding failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted role binding %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchRoleBindingByID queries GET /access/api/v1/role-bindings/:id and returns the role binding.
+func fetchRoleBindingByID(ctx context.Context, client *apiClient, id string) (*RoleBindingInfo, error) {
+	u := fmt.Sprintf("%s/access/api/v1/role-bindings/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("role binding fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var rb RoleBindingInfo
+	if err := decodeAPIResponse(resp, &rb); err != nil {
+		return nil, err
+	}
+	return &rb, nil
+}
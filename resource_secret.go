@@ -3,37 +3,71 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
+	"math/big"
 	"net/http"
+	"regexp"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // SecretPayload represents the JSON body sent to create/update secrets.
 type SecretPayload struct {
-	Name        string            `json:"name"`
-	Description string            `json:"description,omitempty"`
-	Data        map[string]string `json:"data"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Data        map[string]string  `json:"data"`
+	Metadata    map[string]string  `json:"metadata,omitempty"`
+	SyncTo      []SecretSyncTarget `json:"syncTo,omitempty"`
+	Rotate      bool               `json:"rotate,omitempty"` // Record the update as an explicit rotation
 }
 
-// SecretInfo represents the JSON structure returned from the API.
-type SecretInfo struct {
-	ID          string            `json:"id"`
-	Name        string            `json:"name"`
-	Description string            `json:"description,omitempty"`
-	Data        map[string]string `json:"data"`
-	CreatedAt   string            `json:"createdAt,omitempty"`
-	UpdatedAt   string            `json:"updatedAt,omitempty"`
+// SecretMergePayload represents the JSON body sent to PATCH a secret with merge_on_update.
+// Data keys are merged into the stored secret; a null value deletes the key.
+type SecretMergePayload struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Data        map[string]*string `json:"data"`
+	Metadata    map[string]string  `json:"metadata,omitempty"`
+	SyncTo      []SecretSyncTarget `json:"syncTo,omitempty"`
+	Rotate      bool               `json:"rotate,omitempty"`
+}
+
+// SecretSyncTarget is a Kubernetes Secret the backend keeps in sync with a secret.
+type SecretSyncTarget struct {
+	ClusterName string `json:"clusterName"`
+	Namespace   string `json:"namespace"`
+	SecretName  string `json:"secretName"`
 }
 
-// SecretsListResponse represents the response from GET /secrets/api/v1/secrets.
-type SecretsListResponse struct {
-	Secrets []SecretInfo `json:"secrets"`
+// secretIdentityFields are the SecretInfo fields that identify a secret in a list response.
+var secretIdentityFields = []string{"id", "name"}
+
+// SecretInfo represents the JSON structure returned from the API.
+type SecretInfo struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Data        map[string]string  `json:"data"`
+	CreatedAt   string             `json:"createdAt,omitempty"`
+	UpdatedAt   string             `json:"updatedAt,omitempty"`
+	Metadata    map[string]string  `json:"metadata,omitempty"`
+	SyncTo      []SecretSyncTarget `json:"syncTo,omitempty"`
+
+	Version       int    `json:"version,omitempty"`
+	LastRotatedAt string `json:"lastRotatedAt,omitempty"`
+
+	// ResourceVersion identifies this revision of the secret for If-Match. Filled from
+	// the ETag response header when the body does not report it.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
 }
 
 // resourceSecret defines the bugx_secret resource schema and CRUD.
@@ -46,6 +80,11 @@ func resourceSecret() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			resourceSecretGenerateDiff,
+			resourceSecretRotationDiff,
+			resourceSecretEncryptionDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,10 +99,132 @@ func resourceSecret() *schema.Resource {
 			},
 			"data": {
 				Type:        schema.TypeMap,
-				Required:    true,
+				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Key-value pairs of secret data",
 				Sensitive:   true,
+				// With encryption or hash_values, state holds ciphertext or hashes; compare them against the plaintext config.
+				DiffSuppressFunc: suppressProtectedValueDiff,
+			},
+			"hash_values": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"encryption"},
+				Description:   "Store only a salted HMAC-SHA256 of each data value in state instead of the plaintext. Changes are detected by comparing MACs (default: false)",
+			},
+			"encryption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Encrypt data values client-side before sending them. Only ciphertext is sent to the API and stored in state. Overrides the provider's secret_encryption",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"encryption.0.kms_key_id", "encryption.0.public_key_pem"},
+							Description:  "ID of a platform KMS key whose RSA public key is used for encryption",
+						},
+						"public_key_pem": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validatePublicKeyPEM,
+							Description:  "PEM encoded RSA public key used for encryption",
+						},
+					},
+				},
+			},
+			"sync_to": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"encryption"},
+				Description:   "Kubernetes Secrets the backend materializes and keeps in sync with this secret inside bugx clusters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the bugx cluster to create the Kubernetes Secret in",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "default",
+							Description: "Namespace of the Kubernetes Secret (default: 'default')",
+						},
+						"secret_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 subdomain name"),
+							Description:  "Name of the Kubernetes Secret",
+						},
+					},
+				},
+			},
+			"generate": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"hash_values", "encryption"},
+				Description:   "Keys whose values the provider generates randomly on create, exported in generated_values. Cannot be combined with hash_values or encryption, because generated values are kept in state in plaintext",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Data key to generate a value for. Must not also be set in data",
+						},
+						"length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      32,
+							ValidateFunc: validation.IntBetween(8, 1024),
+							Description:  "Length of the generated value (default: 32)",
+						},
+						"charset": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultGenerateCharset,
+							ValidateFunc: validation.StringLenBetween(2, 256),
+							Description:  "Characters the generated value is drawn from (default: letters and digits)",
+						},
+					},
+				},
+			},
+			"generated_values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Values generated for the generate blocks, by key. Stored in state in plaintext",
+			},
+			"merge_on_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Merge data keys into the stored secret on update instead of replacing the whole map, so keys added outside of Terraform are kept (default: false)",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary keepers; changing any of them records an explicit rotation of the secret",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Version of the secret, incremented by the API on every data change or rotation",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Revision of the secret seen on the last refresh. Updates are sent with If-Match so changes made elsewhere in the meantime are reported as a conflict",
+			},
+			"last_rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last rotation",
 			},
 			"created_at": {
 				Type:        schema.TypeString,
@@ -75,6 +236,11 @@ func resourceSecret() *schema.Resource {
 				Computed:    true,
 				Description: "Timestamp when the secret was last updated",
 			},
+			"managed_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Managed-by stamp carried by the secret, see the provider's managed_by option",
+			},
 		},
 	}
 }
@@ -90,18 +256,237 @@ func buildSecretPayload(d *schema.ResourceData) SecretPayload {
 		payload.Description = desc
 	}
 
-	// Convert the map[string]interface{} to map[string]string
+	for k, v := range configuredSecretData(d) {
+		payload.Data[k] = v
+	}
+
+	if targets, ok := d.Get("sync_to").(*schema.Set); ok {
+		for _, t := range targets.List() {
+			raw := t.(map[string]interface{})
+			payload.SyncTo = append(payload.SyncTo, SecretSyncTarget{
+				ClusterName: raw["cluster_name"].(string),
+				Namespace:   raw["namespace"].(string),
+				SecretName:  raw["secret_name"].(string),
+			})
+		}
+	}
+
+	return payload
+}
+
+// buildSecretMergePayload converts payload for a merge update, adding a null value for
+// every key that was in state but is no longer configured.
+func buildSecretMergePayload(d *schema.ResourceData, payload SecretPayload) SecretMergePayload {
+	merge := SecretMergePayload{
+		Name:        payload.Name,
+		Description: payload.Description,
+		Data:        make(map[string]*string, len(payload.Data)),
+		Metadata:    payload.Metadata,
+		SyncTo:      payload.SyncTo,
+		Rotate:      payload.Rotate,
+	}
+	for k, v := range payload.Data {
+		v := v
+		merge.Data[k] = &v
+	}
+
+	oldData, _ := d.GetChange("data")
+	for k := range oldData.(map[string]interface{}) {
+		if _, ok := payload.Data[k]; !ok {
+			merge.Data[k] = nil
+		}
+	}
+	return merge
+}
+
+// configuredSecretData returns the data map as written in the configuration. With
+// hash_values or encryption, d.Get("data") holds the hash or ciphertext from state for
+// keys whose diff was suppressed, which must not be sent to the API as their value.
+func configuredSecretData(d *schema.ResourceData) map[string]string {
+	data := make(map[string]string)
+	if raw, diags := d.GetRawConfigAt(cty.GetAttrPath("data")); !diags.HasError() && raw.IsWhollyKnown() && !raw.IsNull() &&
+		(raw.Type().IsMapType() || raw.Type().IsObjectType()) {
+		for it := raw.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			if !v.IsNull() && v.Type().Equals(cty.String) {
+				data[k.AsString()] = v.AsString()
+			}
+		}
+		return data
+	}
+
+	// No raw configuration, e.g. during import; fall back to the planned values.
 	if dataMap, ok := d.Get("data").(map[string]interface{}); ok {
 		for k, v := range dataMap {
 			if strVal, ok := v.(string); ok {
-				payload.Data[k] = strVal
+				data[k] = strVal
 			}
 		}
 	}
+	return data
+}
 
-	return payload
+// secretDataChanged reports whether the planned data differs from state, not counting
+// keys whose hash or ciphertext in state still matches the configured plaintext.
+func secretDataChanged(d *schema.ResourceDiff) bool {
+	if !d.HasChange("data") {
+		return false
+	}
+	if !d.NewValueKnown("data") {
+		return true
+	}
+	o, n := d.GetChange("data")
+	oldData, newData := o.(map[string]interface{}), n.(map[string]interface{})
+	if len(oldData) != len(newData) {
+		return true
+	}
+	for k, v := range newData {
+		old, ok := oldData[k].(string)
+		if !ok {
+			return true
+		}
+		if value, _ := v.(string); value != old && !suppressProtectedValueDiff(k, old, value, nil) {
+			return true
+		}
+	}
+	return false
+}
+
+// managedSecretData drops keys Terraform does not manage from data, so keys added
+// outside of Terraform do not show up as a diff. All keys are kept when nothing is
+// managed yet, e.g. after an import.
+func managedSecretData(data map[string]string, managed map[string]interface{}) map[string]string {
+	if len(managed) == 0 {
+		return data
+	}
+	filtered := make(map[string]string, len(managed))
+	for k, v := range data {
+		if _, ok := managed[k]; ok {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
+// defaultGenerateCharset is the character set generate blocks draw from by default.
+const defaultGenerateCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// resolveGeneratedSecretValues returns a value for every generate block. Values already in
+// state are kept unless the block's length or charset changed; other keys get a new value.
+func resolveGeneratedSecretValues(d *schema.ResourceData) (map[string]string, error) {
+	oldBlocks, newBlocks := d.GetChange("generate")
+	oldValues, _ := d.GetChange("generated_values")
+
+	previous := make(map[string]map[string]interface{})
+	for _, b := range oldBlocks.([]interface{}) {
+		raw := b.(map[string]interface{})
+		previous[raw["key"].(string)] = raw
+	}
+	existing := oldValues.(map[string]interface{})
+
+	values := make(map[string]string)
+	for _, b := range newBlocks.([]interface{}) {
+		raw := b.(map[string]interface{})
+		key := raw["key"].(string)
+		if prev, ok := previous[key]; ok && prev["length"] == raw["length"] && prev["charset"] == raw["charset"] {
+			if v, ok := existing[key].(string); ok && v != "" {
+				values[key] = v
+				continue
+			}
+		}
+		v, err := generateSecretValue(raw["length"].(int), raw["charset"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate value for key %s: %w", key, err)
+		}
+		values[key] = v
+	}
+	return values, nil
+}
+
+// generateSecretValue returns a random string of length characters drawn from charset.
+func generateSecretValue(length int, charset string) (string, error) {
+	chars := []rune(charset)
+	max := big.NewInt(int64(len(chars)))
+	out := make([]rune, length)
+	for i := range out {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		out[i] = chars[n.Int64()]
+	}
+	return string(out), nil
+}
+
+// resourceSecretGenerateDiff rejects generate keys that are duplicated or also set in data,
+// and marks generated_values as unknown when the generate blocks change.
+func resourceSecretGenerateDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	data := d.Get("data").(map[string]interface{})
+	seen := make(map[string]bool)
+	for _, b := range d.Get("generate").([]interface{}) {
+		raw, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, _ := raw["key"].(string)
+		if key == "" {
+			continue
+		}
+		if seen[key] {
+			return fmt.Errorf("generate key %q is declared more than once", key)
+		}
+		seen[key] = true
+		if _, ok := data[key]; ok {
+			return fmt.Errorf("generate key %q is also set in data", key)
+		}
+	}
+
+	if d.Id() != "" && d.HasChange("generate") {
+		return d.SetNewComputed("generated_values")
+	}
+	return nil
+}
+
+// resourceSecretRotationDiff marks version and last_rotated_at as unknown when the update
+// changes the secret's data or rotates it, so dependent resources see the new version.
+func resourceSecretRotationDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if secretDataChanged(d) || d.HasChange("generate") || d.HasChange("rotation_trigger") {
+		if err := d.SetNewComputed("version"); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("rotation_trigger") {
+		return d.SetNewComputed("last_rotated_at")
+	}
+	return nil
 }
 
+// resourceSecretEncryptionDiff rejects hash_values and sync_to on secrets encrypted by
+// the provider-level secret_encryption block; ConflictsWith only covers the resource's
+// own encryption block.
+func resourceSecretEncryptionDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil || client.SecretEncryption == nil || len(d.Get("encryption").([]interface{})) > 0 {
+		return nil
+	}
+	if d.Get("hash_values").(bool) {
+		return fmt.Errorf("hash_values cannot be used while the provider's secret_encryption is configured")
+	}
+	if len(d.Get("generate").([]interface{})) > 0 {
+		return fmt.Errorf("generate cannot be used while the provider's secret_encryption is configured: generated values are stored in state in plaintext")
+	}
+	if d.Get("sync_to").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("sync_to cannot be used while the provider's secret_encryption is configured: the backend cannot materialize encrypted values")
+	}
+	return nil
+}
+
+// kubernetesNamePattern matches a lowercase RFC 1123 subdomain, as required for Secret names.
+var kubernetesNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
 // resourceSecretCreate calls POST /secrets/api/v1/secrets.
 func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client, ok := m.(*apiClient)
@@ -110,6 +495,17 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	payload := buildSecretPayload(d)
+	payload.Metadata = client.stampManagedBy(payload.Metadata)
+	generated, err := resolveGeneratedSecretValues(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range generated {
+		payload.Data[k] = v
+	}
+	if err := encryptSecretPayload(ctx, client, d, &payload); err != nil {
+		return diag.FromErr(err)
+	}
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return diag.FromErr(err)
@@ -131,13 +527,8 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		req.Header.Set("Authorization", authHeader)
 	}
 
-	// Set GetBody for retry support
-	if req.Body != nil {
-		bodyBytes, _ := io.ReadAll(req.Body)
-		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-		req.GetBody = func() (io.ReadCloser, error) {
-			return io.NopCloser(bytes.NewReader(bodyBytes)), nil
-		}
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
 	}
 
 	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
@@ -150,12 +541,15 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		b, _ := io.ReadAll(resp.Body)
 		return diag.Errorf("create secret failed: %s: %s", resp.Status, string(b))
 	}
+	_ = d.Set("generated_values", generated)
 
 	// Read the created secret from response
 	var secret SecretInfo
-	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+	if err := decodeAPIResponse(resp, &secret); err != nil {
 		// If response doesn't contain the secret, try to fetch it by name
-		log.Printf("[WARN] failed to decode create response, will fetch by name: %v", err)
+		if diags := client.softFailure("failed to decode create secret response, will fetch by name: %v", err); diags.HasError() {
+			return diags
+		}
 		return resourceSecretRead(ctx, d, m)
 	}
 
@@ -188,7 +582,9 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 		// Try GET /secrets/api/v1/secrets/:id
 		secret, err = fetchSecretByID(ctx, client, resourceID)
 		if err != nil {
-			log.Printf("[WARN] failed to fetch secret by ID %s: %v", resourceID, err)
+			if diags := client.softFailure("failed to fetch secret by ID %s: %v", resourceID, err); diags.HasError() {
+				return diags
+			}
 		}
 	}
 
@@ -196,7 +592,9 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 	if secret == nil {
 		secret, err = fetchSecretByName(ctx, client, name)
 		if err != nil {
-			log.Printf("[WARN] failed to fetch secret by name %s: %v", name, err)
+			if diags := client.softFailure("failed to fetch secret by name %s: %v", name, err); diags.HasError() {
+				return diags
+			}
 		}
 	}
 
@@ -209,9 +607,32 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 	// Update state with the secret data
 	_ = d.Set("name", secret.Name)
 	_ = d.Set("description", secret.Description)
-	_ = d.Set("data", secret.Data)
+	data := secret.Data
+	// Generated keys are exported in generated_values, not data.
+	for _, b := range d.Get("generate").([]interface{}) {
+		delete(data, b.(map[string]interface{})["key"].(string))
+	}
+	if d.Get("merge_on_update").(bool) {
+		data = managedSecretData(data, d.Get("data").(map[string]interface{}))
+	}
+	if d.Get("hash_values").(bool) {
+		hashed, err := hashSecretData(data, d.Get("data").(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		data = hashed
+	}
+	_ = d.Set("data", data)
+	_ = d.Set("version", secret.Version)
+	_ = d.Set("resource_version", secret.ResourceVersion)
+	_ = d.Set("last_rotated_at", secret.LastRotatedAt)
 	_ = d.Set("created_at", secret.CreatedAt)
 	_ = d.Set("updated_at", secret.UpdatedAt)
+	_ = d.Set("managed_by", secret.Metadata[managedByKey])
+	// Older backends do not report sync targets; keep the configured ones in that case.
+	if secret.SyncTo != nil {
+		_ = d.Set("sync_to", flattenSecretSyncTargets(secret.SyncTo))
+	}
 
 	// Ensure ID is set
 	if secret.ID != "" {
@@ -236,18 +657,47 @@ func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.Errorf("secret ID is required for update")
 	}
 
+	if diags := verifySecretManagedBy(ctx, client, resourceID); diags.HasError() {
+		return diags
+	}
+
 	payload := buildSecretPayload(d)
-	body, err := json.Marshal(payload)
+	payload.Metadata = client.stampManagedBy(payload.Metadata)
+	payload.Rotate = d.HasChange("rotation_trigger")
+	generated, err := resolveGeneratedSecretValues(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range generated {
+		payload.Data[k] = v
+	}
+	if err := encryptSecretPayload(ctx, client, d, &payload); err != nil {
+		return diag.FromErr(err)
+	}
+
+	method := http.MethodPut
+	var body []byte
+	if d.Get("merge_on_update").(bool) {
+		// PATCH merges the managed keys and deletes the ones removed from config.
+		method = http.MethodPatch
+		body, err = json.Marshal(buildSecretMergePayload(d, payload))
+	} else {
+		body, err = json.Marshal(payload)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	// Use PUT /secrets/api/v1/secrets/:id endpoint
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/secrets/api/v1/secrets/%s", client.BaseURL, resourceID), bytes.NewReader(body))
+	// Use PUT (or PATCH) /secrets/api/v1/secrets/:id endpoint
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/secrets/api/v1/secrets/%s", client.BaseURL, resourceID), bytes.NewReader(body))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	// Only apply the update if nobody changed the secret since the last refresh.
+	if rv := d.Get("resource_version").(string); rv != "" {
+		req.Header.Set("If-Match", rv)
+	}
 
 	// Set Authorization header
 	authHeader := client.Token
@@ -258,25 +708,24 @@ func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		req.Header.Set("Authorization", authHeader)
 	}
 
-	// Set GetBody for retry support
-	if req.Body != nil {
-		bodyBytes, _ := io.ReadAll(req.Body)
-		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-		req.GetBody = func() (io.ReadCloser, error) {
-			return io.NopCloser(bytes.NewReader(bodyBytes)), nil
-		}
-	}
-
 	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
 	if diags != nil && diags.HasError() {
 		return diags
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("secret %s was modified outside of Terraform", d.Get("name").(string)),
+			Detail:   "The secret changed since it was last refreshed, for example in the web UI, so the update was not applied to avoid overwriting that change. Run terraform plan again to review the current secret and re-apply.",
+		}}
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		b, _ := io.ReadAll(resp.Body)
 		return diag.Errorf("update secret failed: %s: %s", resp.Status, string(b))
 	}
+	_ = d.Set("generated_values", generated)
 
 	return resourceSecretRead(ctx, d, m)
 }
@@ -311,6 +760,10 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		return nil
 	}
 
+	if diags := verifySecretManagedBy(ctx, client, resourceID); diags.HasError() {
+		return diags
+	}
+
 	// Use DELETE /secrets/api/v1/secrets/:id endpoint
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/secrets/api/v1/secrets/%s", client.BaseURL, resourceID), nil)
 	if err != nil {
@@ -331,14 +784,16 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	if diags != nil && diags.HasError() {
 		// Verify deletion by trying to read the secret
 		log.Printf("[WARN] delete request returned error, verifying secret deletion...")
-		time.Sleep(2 * time.Second)
+		if err := sleepContext(ctx, 2*time.Second); err != nil {
+			return diags
+		}
 
 		secret, checkErr := fetchSecretByID(ctx, client, resourceID)
 		if checkErr != nil {
 			log.Printf("[WARN] failed to verify secret deletion: %v", checkErr)
 		}
 
-		if secret == nil {
+		if checkErr == nil && secret == nil {
 			log.Printf("[INFO] secret %s successfully deleted (verified)", resourceID)
 			d.SetId("")
 			return nil
@@ -368,7 +823,9 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 		// Verify deletion
 		log.Printf("[WARN] delete returned status %s, verifying secret deletion...", resp.Status)
-		time.Sleep(2 * time.Second)
+		if err := sleepContext(ctx, 2*time.Second); err != nil {
+			return diag.Errorf("delete secret failed: %s: %s", resp.Status, bodyStr)
+		}
 		secret, checkErr := fetchSecretByID(ctx, client, resourceID)
 		if checkErr == nil && secret == nil {
 			log.Printf("[INFO] secret %s successfully deleted (verified despite error status)", resourceID)
@@ -383,6 +840,34 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
+// flattenSecretSyncTargets converts sync targets to the sync_to set representation.
+func flattenSecretSyncTargets(targets []SecretSyncTarget) []interface{} {
+	out := make([]interface{}, 0, len(targets))
+	for _, t := range targets {
+		out = append(out, map[string]interface{}{
+			"cluster_name": t.ClusterName,
+			"namespace":    t.Namespace,
+			"secret_name":  t.SecretName,
+		})
+	}
+	return out
+}
+
+// verifySecretManagedBy checks the live secret's stamp when require_managed_by is set.
+func verifySecretManagedBy(ctx context.Context, client *apiClient, id string) diag.Diagnostics {
+	if !client.RequireManagedBy {
+		return nil
+	}
+	secret, err := fetchSecretByID(ctx, client, id)
+	if err != nil {
+		return client.softFailure("failed to fetch secret %s to verify its managed-by stamp: %v", id, err)
+	}
+	if secret == nil {
+		return nil
+	}
+	return client.verifyManagedBy("secret", secret.Name, secret.Metadata[managedByKey])
+}
+
 // fetchSecretByID queries GET /secrets/api/v1/secrets/:id and returns the secret.
 func fetchSecretByID(ctx context.Context, client *apiClient, id string) (*SecretInfo, error) {
 	u := fmt.Sprintf("%s/secrets/api/v1/secrets/%s", client.BaseURL, id)
@@ -417,14 +902,34 @@ func fetchSecretByID(ctx context.Context, client *apiClient, id string) (*Secret
 	}
 
 	var secret SecretInfo
-	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+	if err := decodeAPIResponse(resp, &secret); err != nil {
 		return nil, err
 	}
+	if secret.ResourceVersion == "" {
+		secret.ResourceVersion = resp.Header.Get("ETag")
+	}
 	return &secret, nil
 }
 
 // fetchSecretByName queries GET /secrets/api/v1/secrets and finds the secret by name.
 func fetchSecretByName(ctx context.Context, client *apiClient, name string) (*SecretInfo, error) {
+	secrets, err := fetchSecrets(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find secret by name
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return &secret, nil
+		}
+	}
+
+	return nil, nil // Not found
+}
+
+// fetchSecrets queries GET /secrets/api/v1/secrets and returns all secrets visible to the caller.
+func fetchSecrets(ctx context.Context, client *apiClient) ([]SecretInfo, error) {
 	u := fmt.Sprintf("%s/secrets/api/v1/secrets", client.BaseURL)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
@@ -453,17 +958,9 @@ func fetchSecretByName(ctx context.Context, client *apiClient, name string) (*Se
 		return nil, fmt.Errorf("secrets list fetch failed: %s: %s", resp.Status, string(b))
 	}
 
-	var listResp SecretsListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+	var secrets []SecretInfo
+	if err := decodeAPIList(resp, client.APICompatibility, "secrets", secretIdentityFields, &secrets); err != nil {
 		return nil, err
 	}
-
-	// Find secret by name
-	for _, secret := range listResp.Secrets {
-		if secret.Name == name {
-			return &secret, nil
-		}
-	}
-
-	return nil, nil // Not found
+	return secrets, nil
 }
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SilenceMatcher represents a single alert label matcher.
+type SilenceMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// SilencePayload represents the JSON body sent to create silences.
+type SilencePayload struct {
+	ClusterName string           `json:"clusterName"`
+	Matchers    []SilenceMatcher `json:"matchers"`
+	StartsAt    string           `json:"startsAt"`
+	EndsAt      string           `json:"endsAt"`
+	Comment     string           `json:"comment"`
+	CreatedBy   string           `json:"createdBy,omitempty"`
+}
+
+// SilenceInfo represents the JSON structure returned from the silences API.
+type SilenceInfo struct {
+	ID          string           `json:"id"`
+	ClusterName string           `json:"clusterName"`
+	Matchers    []SilenceMatcher `json:"matchers"`
+	StartsAt    string           `json:"startsAt"`
+	EndsAt      string           `json:"endsAt"`
+	Comment     string           `json:"comment"`
+	CreatedBy   string           `json:"createdBy,omitempty"`
+	Status      string           `json:"status"`
+}
+
+// resourceSilences defines the bugx_silences resource schema and CRUD.
+// Silences are immutable: any change expires the current silence and creates a new one.
+func resourceSilences() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSilencesCreate,
+		ReadContext:   resourceSilencesRead,
+		DeleteContext: resourceSilencesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster whose alerts are silenced",
+			},
+			"matcher": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "Alert label matchers. An alert is silenced when all matchers apply",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Alert label name (e.g., 'alertname')",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Label value to match",
+						},
+						"is_regex": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+							Description: "Treat value as a regular expression (default: false)",
+						},
+						"is_equal": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     true,
+							Description: "Match when the label equals value; set to false to match when it differs (default: true)",
+						},
+					},
+				},
+			},
+			"duration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDuration,
+				Description:  "How long the silence lasts from creation, as a Go duration (e.g., '2h', '90m')",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Reason for the silence, shown to on-call engineers",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "terraform",
+				Description: "Author recorded on the silence (default: 'terraform')",
+			},
+			"starts_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the silence started",
+			},
+			"ends_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the silence expires",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Silence status reported by the API (e.g., 'active', 'expired')",
+			},
+		},
+	}
+}
+
+// validateDuration checks that a string attribute is a valid Go duration.
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %q to be a string", k)}
+	}
+	dur, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a valid duration (e.g., '2h'): %v", k, err)}
+	}
+	if dur <= 0 {
+		return nil, []error{fmt.Errorf("%q must be positive", k)}
+	}
+	return nil, nil
+}
+
+// buildSilencePayload converts Terraform config to API payload.
+func buildSilencePayload(d *schema.ResourceData) SilencePayload {
+	duration, _ := time.ParseDuration(d.Get("duration").(string))
+	now := time.Now().UTC()
+
+	payload := SilencePayload{
+		ClusterName: d.Get("cluster_name").(string),
+		StartsAt:    now.Format(time.RFC3339),
+		EndsAt:      now.Add(duration).Format(time.RFC3339),
+		Comment:     d.Get("comment").(string),
+		CreatedBy:   d.Get("created_by").(string),
+	}
+
+	for _, raw := range d.Get("matcher").([]interface{}) {
+		mm := raw.(map[string]interface{})
+		payload.Matchers = append(payload.Matchers, SilenceMatcher{
+			Name:    mm["name"].(string),
+			Value:   mm["value"].(string),
+			IsRegex: mm["is_regex"].(bool),
+			IsEqual: mm["is_equal"].(bool),
+		})
+	}
+
+	return payload
+}
+
+// resourceSilencesCreate calls POST /alerts/api/v1/silences.
+func resourceSilencesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildSilencePayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/alerts/api/v1/silences", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create silence failed: %s: %s", resp.Status, string(b))
+	}
+
+	var silence SilenceInfo
+	if err := decodeAPIResponse(resp, &silence); err != nil {
+		return diag.Errorf("failed to decode create silence response: %v", err)
+	}
+	if silence.ID == "" {
+		return diag.Errorf("create silence succeeded but no id returned")
+	}
+
+	d.SetId(silence.ID)
+	log.Printf("[INFO] created silence %s for cluster %s until %s", silence.ID, payload.ClusterName, payload.EndsAt)
+	return resourceSilencesRead(ctx, d, m)
+}
+
+// resourceSilencesRead calls GET /alerts/api/v1/silences/:id.
+func resourceSilencesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	silence, err := fetchSilenceByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if silence == nil {
+		// Silence not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	// An expired silence stays in state so that it is not silently recreated.
+	_ = d.Set("cluster_name", silence.ClusterName)
+	_ = d.Set("comment", silence.Comment)
+	_ = d.Set("starts_at", silence.StartsAt)
+	_ = d.Set("ends_at", silence.EndsAt)
+	_ = d.Set("status", silence.Status)
+	if silence.CreatedBy != "" {
+		_ = d.Set("created_by", silence.CreatedBy)
+	}
+
+	matchers := make([]map[string]interface{}, 0, len(silence.Matchers))
+	for _, sm := range silence.Matchers {
+		matchers = append(matchers, map[string]interface{}{
+			"name":     sm.Name,
+			"value":    sm.Value,
+			"is_regex": sm.IsRegex,
+			"is_equal": sm.IsEqual,
+		})
+	}
+	_ = d.Set("matcher", matchers)
+
+	return nil
+}
+
+// resourceSilencesDelete calls DELETE /alerts/api/v1/silences/:id, which expires the silence.
+func resourceSilencesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/alerts/api/v1/silences/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already expired and purged) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] silence %s not found (already removed)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete silence failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully expired silence %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchSilenceByID queries GET /alerts/api/v1/silences/:id and returns the silence.
+func fetchSilenceByID(ctx context.Context, client *apiClient, id string) (*SilenceInfo, error) {
+	u := fmt.Sprintf("%s/alerts/api/v1/silences/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("silence fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var silence SilenceInfo
+	if err := decodeAPIResponse(resp, &silence); err != nil {
+		return nil, err
+	}
+	return &silence, nil
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SnapshotPayload represents the JSON body sent to create snapshots.
+type SnapshotPayload struct {
+	ClusterName string `json:"clusterName"`
+	Description string `json:"description,omitempty"`
+}
+
+// SnapshotInfo represents the JSON structure returned from the snapshots API.
+type SnapshotInfo struct {
+	ID              string `json:"id"`
+	ClusterName     string `json:"clusterName"`
+	Description     string `json:"description"`
+	Status          string `json:"status"`
+	SizeBytes       int    `json:"sizeBytes"`
+	PlatformVersion string `json:"platformVersion"`
+	CreatedAt       string `json:"createdAt"`
+}
+
+// resourceSnapshot defines the bugx_snapshot resource schema and CRUD.
+// A snapshot is an on-demand, point-in-time copy of a cluster that new clusters can be
+// restored from through bugx_cluster's restore_from_snapshot.
+func resourceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSnapshotCreate,
+		ReadContext:   resourceSnapshotRead,
+		DeleteContext: resourceSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster to snapshot",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Free-form description of the snapshot, e.g. the reason it was taken",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Snapshot status reported by the API (e.g., 'ready')",
+			},
+			"size_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the snapshot in bytes",
+			},
+			"platform_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Platform version of the cluster when the snapshot was taken",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the snapshot was taken",
+			},
+		},
+	}
+}
+
+// snapshotWaitTimeout bounds how long create waits for a snapshot to become ready.
+const snapshotWaitTimeout = 30 * time.Minute
+
+// buildSnapshotPayload converts Terraform state to API payload.
+func buildSnapshotPayload(d *schema.ResourceData) SnapshotPayload {
+	return SnapshotPayload{
+		ClusterName: d.Get("cluster_name").(string),
+		Description: d.Get("description").(string),
+	}
+}
+
+// waitForSnapshot polls the snapshot until the API reports it ready.
+func waitForSnapshot(ctx context.Context, client *apiClient, id string) error {
+	_, err := waitFor(ctx, func(ctx context.Context) (string, bool, error) {
+		snapshot, err := fetchSnapshotByID(ctx, client, id)
+		if err != nil {
+			return "", false, err
+		}
+		if snapshot == nil {
+			return "", false, permanentWaitError(fmt.Errorf("snapshot %s disappeared while waiting for it", id))
+		}
+		return snapshot.Status, snapshot.Status == "ready", nil
+	}, WaitConfig{
+		Timeout:           snapshotWaitTimeout,
+		InitialInterval:   5 * time.Second,
+		MaxInterval:       30 * time.Second,
+		BackoffMultiplier: 1.5,
+		FailureStates:     []string{"failed"},
+		OnProgress: func(attempt int, state string, err error) {
+			if err == nil && state != "" {
+				log.Printf("[INFO] snapshot %s status: %s", id, state)
+			}
+		},
+	})
+	return err
+}
+
+// resourceSnapshotCreate calls POST /backup/api/v1/snapshots.
+func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildSnapshotPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/backup/api/v1/snapshots", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create snapshot failed: %s: %s", resp.Status, string(b))
+	}
+
+	var snapshot SnapshotInfo
+	if err := decodeAPIResponse(resp, &snapshot); err != nil {
+		return diag.Errorf("failed to decode create snapshot response: %v", err)
+	}
+	if snapshot.ID == "" {
+		return diag.Errorf("create snapshot succeeded but no id returned")
+	}
+
+	d.SetId(snapshot.ID)
+
+	if err := waitForSnapshot(ctx, client, snapshot.ID); err != nil {
+		if isInterrupted(err) {
+			return interruptedDiags(
+				fmt.Sprintf("Interrupted while waiting for snapshot %s to become ready", snapshot.ID),
+				"The snapshot is recorded in state as tainted and will be replaced on the next apply.",
+			)
+		}
+		return diag.Errorf("snapshot %s did not become ready: %v", snapshot.ID, err)
+	}
+	log.Printf("[INFO] created snapshot %s", snapshot.ID)
+	return resourceSnapshotRead(ctx, d, m)
+}
+
+// resourceSnapshotRead calls GET /backup/api/v1/snapshots/:id.
+func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	snapshot, err := fetchSnapshotByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if snapshot == nil {
+		// Snapshot not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", snapshot.ClusterName)
+	_ = d.Set("description", snapshot.Description)
+	_ = d.Set("status", snapshot.Status)
+	_ = d.Set("size_bytes", snapshot.SizeBytes)
+	_ = d.Set("platform_version", snapshot.PlatformVersion)
+	_ = d.Set("created_at", snapshot.CreatedAt)
+
+	return nil
+}
+
+// resourceSnapshotDelete calls DELETE /backup/api/v1/snapshots/:id.
+func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/backup/api/v1/snapshots/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] snapshot %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete snapshot failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted snapshot %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchSnapshotByID queries GET /backup/api/v1/snapshots/:id and returns the snapshot.
+func fetchSnapshotByID(ctx context.Context, client *apiClient, id string) (*SnapshotInfo, error) {
+	u := fmt.Sprintf("%s/backup/api/v1/snapshots/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("snapshot fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var snapshot SnapshotInfo
+	if err := decodeAPIResponse(resp, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
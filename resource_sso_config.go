@@ -0,0 +1,349 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// SSOConfigPayload represents the JSON body sent to create/update SSO configs.
+type SSOConfigPayload struct {
+	ClusterName    string `json:"clusterName"`
+	IssuerURL      string `json:"issuerUrl"`
+	ClientID       string `json:"clientId"`
+	UsernameClaim  string `json:"usernameClaim"`
+	UsernamePrefix string `json:"usernamePrefix,omitempty"`
+	GroupsClaim    string `json:"groupsClaim"`
+	GroupsPrefix   string `json:"groupsPrefix,omitempty"`
+	CAPem          string `json:"caPem,omitempty"`
+}
+
+// SSOConfigInfo represents the JSON structure returned from the SSO configs API.
+type SSOConfigInfo struct {
+	ID             string `json:"id"`
+	ClusterName    string `json:"clusterName"`
+	IssuerURL      string `json:"issuerUrl"`
+	ClientID       string `json:"clientId"`
+	UsernameClaim  string `json:"usernameClaim"`
+	UsernamePrefix string `json:"usernamePrefix"`
+	GroupsClaim    string `json:"groupsClaim"`
+	GroupsPrefix   string `json:"groupsPrefix"`
+	CAPem          string `json:"caPem"`
+	Status         string `json:"status"`
+}
+
+// resourceSSOConfig defines the bugx_sso_config resource schema and CRUD.
+// The platform configures the cluster's API server to accept OIDC ID tokens from the issuer.
+func resourceSSOConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSOConfigCreate,
+		ReadContext:   resourceSSOConfigRead,
+		UpdateContext: resourceSSOConfigUpdate,
+		DeleteContext: resourceSSOConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster whose API server is configured",
+			},
+			"issuer_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "HTTPS URL of the OIDC issuer (e.g., 'https://login.example.com/realms/corp')",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID ID tokens must be issued for (the 'aud' claim)",
+			},
+			"username_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "email",
+				Description: "ID token claim used as the Kubernetes username (default: email)",
+			},
+			"username_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix added to usernames, e.g. 'oidc:', to keep them apart from other authenticators",
+			},
+			"groups_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "groups",
+				Description: "ID token claim holding the user's groups (default: groups)",
+			},
+			"groups_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix added to group names, e.g. 'oidc:'",
+			},
+			"ca_pem": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCertificatePEM,
+				Description:  "PEM encoded CA certificate of the issuer, if it is not signed by a public CA",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rollout status reported by the API (e.g., 'applied', 'pending')",
+			},
+		},
+	}
+}
+
+// validateCertificatePEM checks that a value holds at least one PEM encoded certificate.
+func validateCertificatePEM(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil, nil
+	}
+	block, _ := pem.Decode([]byte(s))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, []error{fmt.Errorf("%q: no PEM encoded certificate found", k)}
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, []error{fmt.Errorf("%q: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// buildSSOConfigPayload converts Terraform state to API payload.
+func buildSSOConfigPayload(d *schema.ResourceData) SSOConfigPayload {
+	return SSOConfigPayload{
+		ClusterName:    d.Get("cluster_name").(string),
+		IssuerURL:      d.Get("issuer_url").(string),
+		ClientID:       d.Get("client_id").(string),
+		UsernameClaim:  d.Get("username_claim").(string),
+		UsernamePrefix: d.Get("username_prefix").(string),
+		GroupsClaim:    d.Get("groups_claim").(string),
+		GroupsPrefix:   d.Get("groups_prefix").(string),
+		CAPem:          d.Get("ca_pem").(string),
+	}
+}
+
+// resourceSSOConfigCreate calls POST /access/api/v1/sso-configs.
+func resourceSSOConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildSSOConfigPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/access/api/v1/sso-configs", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create SSO config failed: %s: %s", resp.Status, string(b))
+	}
+
+	var sso SSOConfigInfo
+	if err := decodeAPIResponse(resp, &sso); err != nil {
+		return diag.Errorf("failed to decode create SSO config response: %v", err)
+	}
+	if sso.ID == "" {
+		return diag.Errorf("create SSO config succeeded but no id returned")
+	}
+
+	d.SetId(sso.ID)
+	log.Printf("[INFO] created SSO config %s", sso.ID)
+	return resourceSSOConfigRead(ctx, d, m)
+}
+
+// resourceSSOConfigRead calls GET /access/api/v1/sso-configs/:id.
+func resourceSSOConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	sso, err := fetchSSOConfigByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if sso == nil {
+		// SSO config not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", sso.ClusterName)
+	_ = d.Set("issuer_url", sso.IssuerURL)
+	_ = d.Set("client_id", sso.ClientID)
+	_ = d.Set("username_claim", sso.UsernameClaim)
+	_ = d.Set("username_prefix", sso.UsernamePrefix)
+	_ = d.Set("groups_claim", sso.GroupsClaim)
+	_ = d.Set("groups_prefix", sso.GroupsPrefix)
+	_ = d.Set("ca_pem", sso.CAPem)
+	_ = d.Set("status", sso.Status)
+
+	return nil
+}
+
+// resourceSSOConfigUpdate calls PUT /access/api/v1/sso-configs/:id.
+func resourceSSOConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildSSOConfigPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/access/api/v1/sso-configs/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update SSO config failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceSSOConfigRead(ctx, d, m)
+}
+
+// resourceSSOConfigDelete calls DELETE /access/api/v1/sso-configs/:id.
+func resourceSSOConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/access/api/v1/sso-configs/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] SSO config %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete SSO config failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted SSO config %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchSSOConfigByID queries GET /access/api/v1/sso-configs/:id and returns the SSO config.
+func fetchSSOConfigByID(ctx context.Context, client *apiClient, id string) (*SSOConfigInfo, error) {
+	u := fmt.Sprintf("%s/access/api/v1/sso-configs/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("SSO config fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var sso SSOConfigInfo
+	if err := decodeAPIResponse(resp, &sso); err != nil {
+		return nil, err
+	}
+	return &sso, nil
+}
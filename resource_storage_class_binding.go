@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// StorageClassBindingPayload represents the JSON body sent to create/update storage class bindings.
+type StorageClassBindingPayload struct {
+	ClusterName      string `json:"clusterName"`
+	HostStorageClass string `json:"hostStorageClass"`
+	Name             string `json:"name,omitempty"`
+	IsDefault        bool   `json:"isDefault"`
+}
+
+// StorageClassBindingInfo represents the JSON structure returned from the storage class bindings API.
+type StorageClassBindingInfo struct {
+	ID               string `json:"id"`
+	ClusterName      string `json:"clusterName"`
+	HostStorageClass string `json:"hostStorageClass"`
+	Name             string `json:"name"`
+	IsDefault        bool   `json:"isDefault"`
+	Provisioner      string `json:"provisioner"`
+	ReclaimPolicy    string `json:"reclaimPolicy"`
+}
+
+// resourceStorageClassBinding defines the bugx_storage_class_binding resource schema and CRUD.
+// A binding makes a storage class of the host cluster available inside a bugx cluster, optionally
+// under a different name and as the cluster's default.
+func resourceStorageClassBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStorageClassBindingCreate,
+		ReadContext:   resourceStorageClassBindingRead,
+		UpdateContext: resourceStorageClassBindingUpdate,
+		DeleteContext: resourceStorageClassBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the storage class is made available in",
+			},
+			"host_storage_class": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the storage class on the host cluster (e.g., 'ssd')",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the storage class inside the cluster. Defaults to host_storage_class",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Mark the storage class as the cluster's default (default: false)",
+			},
+			"host_provisioner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provisioner of the host storage class",
+			},
+			"reclaim_policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Reclaim policy of the host storage class (e.g., 'Delete', 'Retain')",
+			},
+		},
+	}
+}
+
+// buildStorageClassBindingPayload converts Terraform state to API payload.
+func buildStorageClassBindingPayload(d *schema.ResourceData) StorageClassBindingPayload {
+	return StorageClassBindingPayload{
+		ClusterName:      d.Get("cluster_name").(string),
+		HostStorageClass: d.Get("host_storage_class").(string),
+		Name:             d.Get("name").(string),
+		IsDefault:        d.Get("is_default").(bool),
+	}
+}
+
+// resourceStorageClassBindingCreate calls POST /storage/api/v1/storage-class-bindings.
+func resourceStorageClassBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildStorageClassBindingPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/storage/api/v1/storage-class-bindings", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create storage class binding failed: %s: %s", resp.Status, string(b))
+	}
+
+	var binding StorageClassBindingInfo
+	if err := decodeAPIResponse(resp, &binding); err != nil {
+		return diag.Errorf("failed to decode create storage class binding response: %v", err)
+	}
+	if binding.ID == "" {
+		return diag.Errorf("create storage class binding succeeded but no id returned")
+	}
+
+	d.SetId(binding.ID)
+	log.Printf("[INFO] created storage class binding %s", binding.ID)
+	return resourceStorageClassBindingRead(ctx, d, m)
+}
+
+// resourceStorageClassBindingRead calls GET /storage/api/v1/storage-class-bindings/:id.
+func resourceStorageClassBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	binding, err := fetchStorageClassBindingByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if binding == nil {
+		// Storage class binding not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", binding.ClusterName)
+	_ = d.Set("host_storage_class", binding.HostStorageClass)
+	_ = d.Set("name", binding.Name)
+	_ = d.Set("is_default", binding.IsDefault)
+	_ = d.Set("host_provisioner", binding.Provisioner)
+	_ = d.Set("reclaim_policy", binding.ReclaimPolicy)
+
+	return nil
+}
+
+// resourceStorageClassBindingUpdate calls PUT /storage/api/v1/storage-class-bindings/:id.
+func resourceStorageClassBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildStorageClassBindingPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/storage/api/v1/storage-class-bindings/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update storage class binding failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceStorageClassBindingRead(ctx, d, m)
+}
+
+// resourceStorageClassBindingDelete calls DELETE /storage/api/v1/storage-class-bindings/:id.
+func resourceStorageClassBindingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/storage/api/v1/storage-class-bindings/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] storage class binding %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete storage class binding failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted storage class binding %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchStorageClassBindingByID queries GET /storage/api/v1/storage-class-bindings/:id and returns the storage class binding.
+func fetchStorageClassBindingByID(ctx context.Context, client *apiClient, id string) (*StorageClassBindingInfo, error) {
+	u := fmt.Sprintf("%s/storage/api/v1/storage-class-bindings/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("storage class binding fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var binding StorageClassBindingInfo
+	if err := decodeAPIResponse(resp, &binding); err != nil {
+		return nil, err
+	}
+	return &binding, nil
+}
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// TeamMember represents one member of a team.
+type TeamMember struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+// TeamQuota represents the limits shared by all clusters of a team. Empty
+// quantities and zero counts are unlimited.
+type TeamQuota struct {
+	CPU         string `json:"cpu,omitempty"`
+	Memory      string `json:"memory,omitempty"`
+	Storage     string `json:"storage,omitempty"`
+	MaxClusters int    `json:"maxClusters,omitempty"`
+}
+
+// TeamPayload represents the JSON body sent to create/update teams.
+type TeamPayload struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Members     []TeamMember `json:"members"`
+	Clusters    []string     `json:"clusters"`
+	Quota       *TeamQuota   `json:"quota,omitempty"`
+}
+
+// TeamInfo represents the JSON structure returned from the teams API.
+type TeamInfo struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Members     []TeamMember `json:"members"`
+	Clusters    []string     `json:"clusters"`
+	Quota       *TeamQuota   `json:"quota,omitempty"`
+	CreatedAt   string       `json:"createdAt"`
+}
+
+// resourceTeam defines the bugx_team resource schema and CRUD.
+// A team groups users and clusters; members get the team's access to its clusters,
+// and the clusters share the team's quota.
+func resourceTeam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamCreate,
+		ReadContext:   resourceTeamRead,
+		UpdateContext: resourceTeamUpdate,
+		DeleteContext: resourceTeamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a lowercase RFC 1123 name"),
+				Description:  "Name of the team (must be unique)",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Free-form description of the team",
+			},
+			"member": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Users that belong to the team. The set is authoritative: members added outside of Terraform are removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Username of the member",
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "member",
+							ValidateFunc: validation.StringInSlice([]string{"maintainer", "member"}, false),
+							Description:  "Role within the team: 'maintainer' (can manage membership) or 'member' (default: member)",
+						},
+					},
+				},
+			},
+			"clusters": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the bugx clusters owned by the team",
+			},
+			"quota": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Limits shared by all clusters of the team",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity, e.g. '16'"),
+							DiffSuppressFunc: suppressEquivalentQuantity,
+							Description:      "Total CPU requests allowed across the team's clusters",
+						},
+						"memory": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity, e.g. '64Gi'"),
+							DiffSuppressFunc: suppressEquivalentQuantity,
+							Description:      "Total memory requests allowed across the team's clusters",
+						},
+						"storage": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringMatch(resourceQuantityPattern, "must be a Kubernetes quantity, e.g. '1Ti'"),
+							DiffSuppressFunc: suppressEquivalentQuantity,
+							Description:      "Total persistent volume storage allowed across the team's clusters",
+						},
+						"max_clusters": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Maximum number of clusters the team may own. 0 means unlimited",
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the team was created",
+			},
+		},
+	}
+}
+
+// buildTeamPayload converts Terraform state to API payload.
+func buildTeamPayload(d *schema.ResourceData) TeamPayload {
+	payload := TeamPayload{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Members:     []TeamMember{},
+		Clusters:    []string{},
+	}
+
+	if members, ok := d.Get("member").(*schema.Set); ok {
+		for _, raw := range members.List() {
+			m := raw.(map[string]interface{})
+			payload.Members = append(payload.Members, TeamMember{
+				Username: m["username"].(string),
+				Role:     m["role"].(string),
+			})
+		}
+	}
+
+	if clusters, ok := d.Get("clusters").(*schema.Set); ok {
+		for _, c := range clusters.List() {
+			payload.Clusters = append(payload.Clusters, c.(string))
+		}
+	}
+
+	if blocks := d.Get("quota").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		q := blocks[0].(map[string]interface{})
+		payload.Quota = &TeamQuota{
+			CPU:         q["cpu"].(string),
+			Memory:      q["memory"].(string),
+			Storage:     q["storage"].(string),
+			MaxClusters: q["max_clusters"].(int),
+		}
+	}
+
+	return payload
+}
+
+// flattenTeamMembers converts API team members to Terraform state.
+func flattenTeamMembers(members []TeamMember) []interface{} {
+	out := make([]interface{}, 0, len(members))
+	for _, m := range members {
+		out = append(out, map[string]interface{}{
+			"username": m.Username,
+			"role":     m.Role,
+		})
+	}
+	return out
+}
+
+// flattenTeamQuota converts an API team quota to Terraform state.
+func flattenTeamQuota(q *TeamQuota) []interface{} {
+	if q == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"cpu":          q.CPU,
+		"memory":       q.Memory,
+		"storage":      q.Storage,
+		"max_clusters": q.MaxClusters,
+	}}
+}
+
+// resourceTeamCreate calls POST /teams/api/v1/teams.
+func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildTeamPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/teams/api/v1/teams", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create team failed: %s: %s", resp.Status, string(b))
+	}
+
+	var team TeamInfo
+	if err := decodeAPIResponse(resp, &team); err != nil {
+		return diag.Errorf("failed to decode create team response: %v", err)
+	}
+	if team.ID == "" {
+		return diag.Errorf("create team succeeded but no id returned")
+	}
+
+	d.SetId(team.ID)
+	log.Printf("[INFO] created team %s", team.ID)
+	return resourceTeamRead(ctx, d, m)
+}
+
+// resourceTeamRead calls GET /teams/api/v1/teams/:id.
+func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	team, err := fetchTeamByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if team == nil {
+		// Team not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", team.Name)
+	_ = d.Set("description", team.Description)
+	_ = d.Set("member", flattenTeamMembers(team.Members))
+	_ = d.Set("clusters", team.Clusters)
+	_ = d.Set("quota", flattenTeamQuota(team.Quota))
+	_ = d.Set("created_at", team.CreatedAt)
+
+	return nil
+}
+
+// resourceTeamUpdate calls PUT /teams/api/v1/teams/:id.
+func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildTeamPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/teams/api/v1/teams/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update team failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceTeamRead(ctx, d, m)
+}
+
+// resourceTeamDelete calls DELETE /teams/api/v1/teams/:id.
+func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/teams/api/v1/teams/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] team %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete team failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted team %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchTeamByID queries GET /teams/api/v1/teams/:id and returns the team.
+func fetchTeamByID(ctx context.Context, client *apiClient, id string) (*TeamInfo, error) {
+	u := fmt.Sprintf("%s/teams/api/v1/teams/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("team fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var team TeamInfo
+	if err := decodeAPIResponse(resp, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
@@ -0,0 +1,365 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// TunnelPayload represents the JSON body sent to create/update tunnels.
+type TunnelPayload struct {
+	ClusterName     string   `json:"clusterName"`
+	Namespace       string   `json:"namespace"`
+	Service         string   `json:"service"`
+	TargetPort      int      `json:"targetPort"`
+	LocalPort       int      `json:"localPort,omitempty"`
+	Protocol        string   `json:"protocol"`
+	AuthMode        string   `json:"authMode"`
+	AllowedNetworks []string `json:"allowedNetworks,omitempty"`
+}
+
+// TunnelInfo represents the JSON structure returned from the tunnels API.
+type TunnelInfo struct {
+	ID              string   `json:"id"`
+	ClusterName     string   `json:"clusterName"`
+	Namespace       string   `json:"namespace"`
+	Service         string   `json:"service"`
+	TargetPort      int      `json:"targetPort"`
+	LocalPort       int      `json:"localPort"`
+	Protocol        string   `json:"protocol"`
+	AuthMode        string   `json:"authMode"`
+	AllowedNetworks []string `json:"allowedNetworks,omitempty"`
+	ExternalAddress string   `json:"externalAddress"`
+	AccessToken     string   `json:"accessToken,omitempty"`
+	Status          string   `json:"status"`
+}
+
+// resourceTunnel defines the bugx_tunnel resource schema and CRUD.
+// A tunnel is a persistent port-forward from the platform edge to a service inside a cluster.
+func resourceTunnel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTunnelCreate,
+		ReadContext:   resourceTunnelRead,
+		UpdateContext: resourceTunnelUpdate,
+		DeleteContext: resourceTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the bugx cluster the target service runs in",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "default",
+				Description: "Namespace of the target service (default: 'default')",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Kubernetes service to forward to",
+			},
+			"target_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Service port to forward to",
+			},
+			"local_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port exposed at the platform edge. If not specified, the platform allocates one",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "tcp",
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "http"}, false),
+				Description:  "Tunnel protocol: 'tcp' or 'http' (default: 'tcp')",
+			},
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "token",
+				ValidateFunc: validation.StringInSlice([]string{"token", "none"}, false),
+				Description:  "How clients authenticate to the tunnel: 'token' requires access_token, 'none' relies on allowed_networks only (default: 'token')",
+			},
+			"allowed_networks": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+				Description: "CIDR ranges allowed to connect to the tunnel. If empty, the platform default applies",
+			},
+			"external_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Address (host:port) clients connect to",
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Token clients present when auth_mode is 'token'",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tunnel status reported by the API (e.g., 'active', 'pending')",
+			},
+		},
+	}
+}
+
+// buildTunnelPayload converts Terraform state to API payload.
+func buildTunnelPayload(d *schema.ResourceData) TunnelPayload {
+	payload := TunnelPayload{
+		ClusterName: d.Get("cluster_name").(string),
+		Namespace:   d.Get("namespace").(string),
+		Service:     d.Get("service").(string),
+		TargetPort:  d.Get("target_port").(int),
+		LocalPort:   d.Get("local_port").(int),
+		Protocol:    d.Get("protocol").(string),
+		AuthMode:    d.Get("auth_mode").(string),
+	}
+
+	if networks, ok := d.Get("allowed_networks").(*schema.Set); ok {
+		for _, n := range networks.List() {
+			payload.AllowedNetworks = append(payload.AllowedNetworks, n.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceTunnelCreate calls POST /tunnels/api/v1/tunnels.
+func resourceTunnelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildTunnelPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/tunnels/api/v1/tunnels", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create tunnel failed: %s: %s", resp.Status, string(b))
+	}
+
+	var tunnel TunnelInfo
+	if err := decodeAPIResponse(resp, &tunnel); err != nil {
+		return diag.Errorf("failed to decode create tunnel response: %v", err)
+	}
+	if tunnel.ID == "" {
+		return diag.Errorf("create tunnel succeeded but no id returned")
+	}
+
+	d.SetId(tunnel.ID)
+	if tunnel.AccessToken != "" {
+		_ = d.Set("access_token", tunnel.AccessToken)
+	}
+	log.Printf("[INFO] created tunnel %s", tunnel.ID)
+	return resourceTunnelRead(ctx, d, m)
+}
+
+// resourceTunnelRead calls GET /tunnels/api/v1/tunnels/:id.
+func resourceTunnelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	tunnel, err := fetchTunnelByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if tunnel == nil {
+		// Tunnel not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_name", tunnel.ClusterName)
+	_ = d.Set("namespace", tunnel.Namespace)
+	_ = d.Set("service", tunnel.Service)
+	_ = d.Set("target_port", tunnel.TargetPort)
+	_ = d.Set("local_port", tunnel.LocalPort)
+	_ = d.Set("protocol", tunnel.Protocol)
+	_ = d.Set("auth_mode", tunnel.AuthMode)
+	_ = d.Set("allowed_networks", tunnel.AllowedNetworks)
+	_ = d.Set("external_address", tunnel.ExternalAddress)
+	_ = d.Set("status", tunnel.Status)
+	// The API may only return the token once; keep the stored value otherwise.
+	if tunnel.AccessToken != "" {
+		_ = d.Set("access_token", tunnel.AccessToken)
+	}
+
+	return nil
+}
+
+// resourceTunnelUpdate calls PUT /tunnels/api/v1/tunnels/:id.
+func resourceTunnelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildTunnelPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/tunnels/api/v1/tunnels/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update tunnel failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceTunnelRead(ctx, d, m)
+}
+
+// resourceTunnelDelete calls DELETE /tunnels/api/v1/tunnels/:id.
+func resourceTunnelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/tunnels/api/v1/tunnels/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] tunnel %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete tunnel failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted tunnel %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchTunnelByID queries GET /tunnels/api/v1/tunnels/:id and returns the tunnel.
+func fetchTunnelByID(ctx context.Context, client *apiClient, id string) (*TunnelInfo, error) {
+	u := fmt.Sprintf("%s/tunnels/api/v1/tunnels/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("tunnel fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var tunnel TunnelInfo
+	if err := decodeAPIResponse(resp, &tunnel); err != nil {
+		return nil, err
+	}
+	return &tunnel, nil
+}
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// UserPayload represents the JSON body sent to create/update users.
+type UserPayload struct {
+	Username    string `json:"username"`
+	Email       string `json:"email"`
+	Role        string `json:"role"`
+	DisplayName string `json:"displayName,omitempty"`
+	Password    string `json:"password,omitempty"` // Only sent on create and on password resets
+}
+
+// UserInfo represents the JSON structure returned from the users API.
+type UserInfo struct {
+	ID          string `json:"id"`
+	Username    string `json:"username"`
+	Email       string `json:"email"`
+	Role        string `json:"role"`
+	DisplayName string `json:"displayName"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+// resourceUser defines the bugx_user resource schema and CRUD.
+// Users are platform accounts; the initial password is write-only and never stored in state.
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Login name of the user",
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(emailPattern, "must be an email address"),
+				Description:  "Email address of the user",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "member", "viewer"}, false),
+				Description:  "Platform role of the user: 'admin', 'member' or 'viewer'",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name shown in the platform console",
+			},
+			"initial_password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringLenBetween(8, 128),
+				Description:  "Write-only initial password. Only sent on create, or when initial_password_wo_version changes. If unset, the platform emails the user an invitation instead",
+			},
+			"initial_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"initial_password_wo"},
+				Description:  "Change to reset the user's password to the current initial_password_wo",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Account status reported by the API (e.g., 'invited', 'active', 'disabled')",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the user was created",
+			},
+		},
+	}
+}
+
+// buildUserPayload converts Terraform state to API payload. The write-only password is
+// read from the raw configuration and only included on create and on password resets.
+func buildUserPayload(d *schema.ResourceData) UserPayload {
+	payload := UserPayload{
+		Username:    d.Get("username").(string),
+		Email:       d.Get("email").(string),
+		Role:        d.Get("role").(string),
+		DisplayName: d.Get("display_name").(string),
+	}
+
+	if d.IsNewResource() || d.HasChange("initial_password_wo_version") {
+		if v, diags := d.GetRawConfigAt(cty.GetAttrPath("initial_password_wo")); !diags.HasError() && v.Type().Equals(cty.String) && !v.IsNull() && v.IsKnown() {
+			payload.Password = v.AsString()
+		}
+	}
+
+	return payload
+}
+
+// resourceUserCreate calls POST /users/api/v1/users.
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildUserPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/users/api/v1/users", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	if err := client.setIdempotencyKey(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create user failed: %s: %s", resp.Status, string(b))
+	}
+
+	var user UserInfo
+	if err := decodeAPIResponse(resp, &user); err != nil {
+		return diag.Errorf("failed to decode create user response: %v", err)
+	}
+	if user.ID == "" {
+		return diag.Errorf("create user succeeded but no id returned")
+	}
+
+	d.SetId(user.ID)
+	log.Printf("[INFO] created user %s", user.ID)
+	return resourceUserRead(ctx, d, m)
+}
+
+// resourceUserRead calls GET /users/api/v1/users/:id.
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	user, err := fetchUserByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if user == nil {
+		// User not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("username", user.Username)
+	_ = d.Set("email", user.Email)
+	_ = d.Set("role", user.Role)
+	_ = d.Set("display_name", user.DisplayName)
+	_ = d.Set("status", user.Status)
+	_ = d.Set("created_at", user.CreatedAt)
+
+	return nil
+}
+
+// resourceUserUpdate calls PUT /users/api/v1/users/:id.
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildUserPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/users/api/v1/users/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update user failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceUserRead(ctx, d, m)
+}
+
+// resourceUserDelete calls DELETE /users/api/v1/users/:id.
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/users/api/v1/users/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] user %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete user failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted user %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchUserByID queries GET /users/api/v1/users/:id and returns the user.
+func fetchUserByID(ctx context.Context, client *apiClient, id string) (*UserInfo, error) {
+	u := fmt.Sprintf("%s/users/api/v1/users/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("user fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var user UserInfo
+	if err := decodeAPIResponse(resp, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
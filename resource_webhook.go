@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// WebhookPayload represents the JSON body sent to create/update webhooks.
+type WebhookPayload struct {
+	URL           string   `json:"url"`
+	Events        []string `json:"events"`
+	ClusterName   string   `json:"clusterName,omitempty"`
+	SigningSecret string   `json:"signingSecret,omitempty"`
+	Enabled       bool     `json:"enabled"`
+}
+
+// WebhookInfo represents the JSON structure returned from the webhooks API. The
+// signing secret is never returned.
+type WebhookInfo struct {
+	ID                 string   `json:"id"`
+	URL                string   `json:"url"`
+	Events             []string `json:"events"`
+	ClusterName        string   `json:"clusterName"`
+	Enabled            bool     `json:"enabled"`
+	LastDeliveryAt     string   `json:"lastDeliveryAt"`
+	LastDeliveryStatus string   `json:"lastDeliveryStatus"`
+}
+
+// resourceWebhook defines the bugx_webhook resource schema and CRUD.
+// The platform POSTs cluster lifecycle events to the webhook's URL as they happen.
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL the platform POSTs events to",
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"cluster.created", "cluster.healthy", "cluster.failed", "cluster.deleted"}, false),
+				},
+				Description: "Lifecycle events to deliver: 'cluster.created', 'cluster.healthy', 'cluster.failed' and/or 'cluster.deleted'",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only deliver events for this cluster. If empty, events for all clusters are delivered",
+			},
+			"signing_secret": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(16, 256),
+				Description:  "Shared secret the platform signs each delivery with (HMAC-SHA256 in the X-Bugx-Signature header)",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether events are delivered (default: true)",
+			},
+			"last_delivery_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the most recent delivery attempt",
+			},
+			"last_delivery_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Outcome of the most recent delivery attempt (e.g., '200 OK', 'timeout')",
+			},
+		},
+	}
+}
+
+// buildWebhookPayload converts Terraform state to API payload.
+func buildWebhookPayload(d *schema.ResourceData) WebhookPayload {
+	payload := WebhookPayload{
+		URL:           d.Get("url").(string),
+		ClusterName:   d.Get("cluster_name").(string),
+		SigningSecret: d.Get("signing_secret").(string),
+		Enabled:       d.Get("enabled").(bool),
+	}
+
+	if events, ok := d.Get("events").(*schema.Set); ok {
+		for _, e := range events.List() {
+			payload.Events = append(payload.Events, e.(string))
+		}
+	}
+
+	return payload
+}
+
+// resourceWebhookCreate calls POST /events/api/v1/webhooks.
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildWebhookPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/events/api/v1/webhooks", client.BaseURL), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("create webhook failed: %s: %s", resp.Status, string(b))
+	}
+
+	var hook WebhookInfo
+	if err := decodeAPIResponse(resp, &hook); err != nil {
+		return diag.Errorf("failed to decode create webhook response: %v", err)
+	}
+	if hook.ID == "" {
+		return diag.Errorf("create webhook succeeded but no id returned")
+	}
+
+	d.SetId(hook.ID)
+	log.Printf("[INFO] created webhook %s", hook.ID)
+	return resourceWebhookRead(ctx, d, m)
+}
+
+// resourceWebhookRead calls GET /events/api/v1/webhooks/:id.
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	hook, err := fetchWebhookByID(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if hook == nil {
+		// Webhook not found; mark resource as gone.
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("url", hook.URL)
+	_ = d.Set("events", hook.Events)
+	_ = d.Set("cluster_name", hook.ClusterName)
+	_ = d.Set("enabled", hook.Enabled)
+	_ = d.Set("last_delivery_at", hook.LastDeliveryAt)
+	_ = d.Set("last_delivery_status", hook.LastDeliveryStatus)
+	// signing_secret is write-only on the API side; keep the configured value.
+
+	return nil
+}
+
+// resourceWebhookUpdate calls PUT /events/api/v1/webhooks/:id.
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	payload := buildWebhookPayload(d)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/events/api/v1/webhooks/%s", client.BaseURL, d.Id()), bytes.NewReader(body))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("update webhook failed: %s: %s", resp.Status, string(b))
+	}
+
+	return resourceWebhookRead(ctx, d, m)
+}
+
+// resourceWebhookDelete calls DELETE /events/api/v1/webhooks/:id.
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, ok := m.(*apiClient)
+	if !ok || client == nil {
+		return diag.Errorf("invalid API client configuration")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/events/api/v1/webhooks/%s", client.BaseURL, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, diags := doRequestWithRetryDiag(ctx, client, req, client.RetryConfig)
+	if diags != nil && diags.HasError() {
+		return diags
+	}
+	defer resp.Body.Close()
+
+	// Accept 200-299 and 404 (already deleted) as success
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[INFO] webhook %s not found (already deleted)", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return diag.Errorf("delete webhook failed: %s: %s", resp.Status, string(b))
+	}
+
+	log.Printf("[INFO] successfully deleted webhook %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// fetchWebhookByID queries GET /events/api/v1/webhooks/:id and returns the webhook.
+func fetchWebhookByID(ctx context.Context, client *apiClient, id string) (*WebhookInfo, error) {
+	u := fmt.Sprintf("%s/events/api/v1/webhooks/%s", client.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("webhook fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var hook WebhookInfo
+	if err := decodeAPIResponse(resp, &hook); err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
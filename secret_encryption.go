@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// encryptedValuePrefix marks secret values encrypted client-side by the provider.
+//
+// Format: enc:v2:<salt>:<hmac-sha256(key, salt||plaintext)>:<payload>
+// salt and payload are base64 (std) encoded, the MAC is hex encoded. The payload is
+// RSA-OAEP(SHA-256) wrapped AES-256 key || GCM nonce || AES-GCM ciphertext; the holder of
+// the private key decrypts it. The MAC only lets the provider detect changes; its key
+// never leaves the provider, so the MAC cannot be used to guess the plaintext offline.
+//
+// enc:v1 values carried an unkeyed SHA-256 hash instead. They never match, so they are
+// re-encrypted in the v2 format on the next apply.
+const encryptedValuePrefix = "enc:v2:"
+
+// secretEncryptionConfig is the provider-level secret_encryption block, used by
+// bugx_secret resources without their own encryption block.
+type secretEncryptionConfig struct {
+	KMSKeyID     string
+	PublicKeyPEM string
+}
+
+// secretChangeKey is the provider's key for the change-detection MAC in enc:v2 values.
+// It is package state because DiffSuppressFunc has no access to the provider meta;
+// Terraform runs a separate provider process for every provider configuration.
+var secretChangeKey struct {
+	sync.RWMutex
+	key []byte
+}
+
+// setSecretChangeKey derives the change-detection key from secret, which is the
+// configured change_detection_key or else the provider password.
+func setSecretChangeKey(secret string) {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("bugx secret change detection"))
+	secretChangeKey.Lock()
+	secretChangeKey.key = mac.Sum(nil)
+	secretChangeKey.Unlock()
+}
+
+// kmsPublicKeyResponse represents the response from GET /kms/api/v1/keys/:id/public.
+type kmsPublicKeyResponse struct {
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// secretEncryptionFor returns the encryption settings of a secret: its own encryption
+// block, else the provider's secret_encryption block, else nil.
+func secretEncryptionFor(client *apiClient, blocks []interface{}) *secretEncryptionConfig {
+	if len(blocks) > 0 && blocks[0] != nil {
+		cfg := blocks[0].(map[string]interface{})
+		return &secretEncryptionConfig{
+			KMSKeyID:     cfg["kms_key_id"].(string),
+			PublicKeyPEM: cfg["public_key_pem"].(string),
+		}
+	}
+	return client.SecretEncryption
+}
+
+// secretEncryptionKey resolves the RSA public key the secret is encrypted for, or nil
+// if the secret is not encrypted client-side.
+func secretEncryptionKey(ctx context.Context, client *apiClient, d *schema.ResourceData) (*rsa.PublicKey, error) {
+	cfg := secretEncryptionFor(client, d.Get("encryption").([]interface{}))
+	if cfg == nil {
+		return nil, nil
+	}
+
+	pemData := cfg.PublicKeyPEM
+	if keyID := cfg.KMSKeyID; keyID != "" {
+		var err error
+		pemData, err = fetchKMSPublicKey(ctx, client, keyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return parseRSAPublicKey(pemData)
+}
+
+// parseRSAPublicKey decodes a PEM encoded PKIX or PKCS#1 RSA public key.
+func parseRSAPublicKey(pemData string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found in public key")
+	}
+
+	if pub, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		rsaPub, ok := pub.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key must be an RSA key, got %T", pub)
+		}
+		return rsaPub, nil
+	}
+
+	pub, err := x509.ParsePKCS1PublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse RSA public key: %w", err)
+	}
+	return pub, nil
+}
+
+// validatePublicKeyPEM checks that public_key_pem holds an RSA public key.
+func validatePublicKeyPEM(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil, nil
+	}
+	if _, err := parseRSAPublicKey(s); err != nil {
+		return nil, []error{fmt.Errorf("%q: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// encryptSecretValue encrypts plaintext for pub and returns it in the enc:v2 format.
+func encryptSecretValue(pub *rsa.PublicKey, plaintext string) (string, error) {
+	salt := make([]byte, 16)
+	dataKey := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", err
+	}
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", err
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	wrappedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, dataKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	payload := append(wrappedKey, nonce...)
+	payload = gcm.Seal(payload, nonce, []byte(plaintext), nil)
+
+	return encryptedValuePrefix +
+		base64.StdEncoding.EncodeToString(salt) + ":" +
+		valueMAC(salt, plaintext) + ":" +
+		base64.StdEncoding.EncodeToString(payload), nil
+}
+
+// encryptedValueMatches reports whether an enc:v2 value was produced from plaintext.
+func encryptedValueMatches(encrypted, plaintext string) bool {
+	if !strings.HasPrefix(encrypted, encryptedValuePrefix) {
+		return false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(encrypted, encryptedValuePrefix), ":", 3)
+	if len(parts) != 3 {
+		return false
+	}
+	salt, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	mac := valueMAC(salt, plaintext)
+	return mac != "" && subtle.ConstantTimeCompare([]byte(parts[1]), []byte(mac)) == 1
+}
+
+// valueMAC returns hex(hmac-sha256(secretChangeKey, salt || value)), or "" if the
+// provider has not been configured with a key.
+func valueMAC(salt []byte, value string) string {
+	secretChangeKey.RLock()
+	key := secretChangeKey.key
+	secretChangeKey.RUnlock()
+	if len(key) == 0 {
+		return ""
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write(salt)
+	h.Write([]byte(value))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// hashedValuePrefix marks secret values stored in state in place of the plaintext when
+// hash_values is enabled.
+//
+// Format: hmac:v1:<salt>:<hmac-sha256(key, salt||value)>
+// salt is base64 (std) encoded, the MAC is hex encoded and keyed like the enc:v2 MAC, so
+// the state cannot be used to guess values offline.
+//
+// Older releases stored unsalted sha256:<hex> hashes. They never match, so the next
+// refresh replaces them with hmac:v1 values.
+const hashedValuePrefix = "hmac:v1:"
+
+// hashSecretValue returns the state representation of value used with hash_values.
+func hashSecretValue(value string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", err
+	}
+	return hashedValuePrefix + base64.StdEncoding.EncodeToString(salt) + ":" + valueMAC(salt, value), nil
+}
+
+// hashSecretData hashes every value of a secret's data map. A value in prior that still
+// matches is kept, so that refreshing an unchanged secret does not rewrite state.
+func hashSecretData(data map[string]string, prior map[string]interface{}) (map[string]string, error) {
+	hashed := make(map[string]string, len(data))
+	for k, v := range data {
+		if old, ok := prior[k].(string); ok && hashedValueMatches(old, v) {
+			hashed[k] = old
+			continue
+		}
+		h, err := hashSecretValue(v)
+		if err != nil {
+			return nil, err
+		}
+		hashed[k] = h
+	}
+	return hashed, nil
+}
+
+// hashedValueMatches reports whether an hmac:v1 value in state was produced from plaintext.
+func hashedValueMatches(hashed, plaintext string) bool {
+	if !strings.HasPrefix(hashed, hashedValuePrefix) {
+		return false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(hashed, hashedValuePrefix), ":", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	salt, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	mac := valueMAC(salt, plaintext)
+	return mac != "" && subtle.ConstantTimeCompare([]byte(parts[1]), []byte(mac)) == 1
+}
+
+// suppressProtectedValueDiff hides the difference between a ciphertext or hash in state
+// and the plaintext in config as long as the plaintext has not changed.
+func suppressProtectedValueDiff(k, old, new string, d *schema.ResourceData) bool {
+	return encryptedValueMatches(old, new) || hashedValueMatches(old, new)
+}
+
+// fetchKMSPublicKey queries GET /kms/api/v1/keys/:id/public and returns the PEM encoded key.
+func fetchKMSPublicKey(ctx context.Context, client *apiClient, keyID string) (string, error) {
+	u := fmt.Sprintf("%s/kms/api/v1/keys/%s/public", client.BaseURL, url.PathEscape(keyID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Set Authorization header
+	authHeader := client.Token
+	if authHeader != "" && len(authHeader) > 7 && authHeader[:7] != "Bearer " {
+		authHeader = "Bearer " + authHeader
+	}
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("kms public key fetch failed: %s: %s", resp.Status, string(b))
+	}
+
+	var keyResp kmsPublicKeyResponse
+	if err := decodeAPIResponse(resp, &keyResp); err != nil {
+		return "", err
+	}
+	if keyResp.PublicKeyPem == "" {
+		return "", fmt.Errorf("kms key %s has no public key", keyID)
+	}
+	return keyResp.PublicKeyPem, nil
+}
+
+// encryptSecretPayload replaces the payload data values with ciphertext when the
+// resource has an encryption block or the provider configures secret_encryption, so
+// plaintext never leaves the provider.
+func encryptSecretPayload(ctx context.Context, client *apiClient, d *schema.ResourceData, payload *SecretPayload) error {
+	pub, err := secretEncryptionKey(ctx, client, d)
+	if err != nil {
+		return fmt.Errorf("failed to resolve secret encryption key: %w", err)
+	}
+	if pub == nil {
+		return nil
+	}
+
+	for k, v := range payload.Data {
+		encrypted, err := encryptSecretValue(pub, v)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt secret key %s: %w", k, err)
+		}
+		payload.Data[k] = encrypted
+	}
+	return nil
+}
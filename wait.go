@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// WaitConfig controls how waitFor polls a check until it reports completion.
+type WaitConfig struct {
+	// Timeout bounds the whole wait. Zero means wait until ctx is done.
+	Timeout time.Duration
+	// InitialInterval is the delay before the second check.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between checks once backoff kicks in.
+	MaxInterval time.Duration
+	// BackoffMultiplier grows the delay after every check (1 keeps it fixed).
+	BackoffMultiplier float64
+	// FailureStates are terminal states that stop the wait with an error.
+	FailureStates []string
+	// OnProgress, if set, is called after every check with its outcome.
+	OnProgress func(attempt int, state string, err error)
+	// Wake, if set, triggers the next check right away, e.g. when the API pushes a
+	// status change. Polling continues on the normal schedule in between.
+	Wake <-chan struct{}
+}
+
+// waitCheckFunc reports the current state of the thing being waited on and
+// whether it has reached its target. A returned error is treated as transient
+// unless wrapped with permanentWaitError.
+type waitCheckFunc func(ctx context.Context) (state string, done bool, err error)
+
+// waitPermanentError marks a check error that must stop the wait immediately.
+type waitPermanentError struct {
+	err error
+}
+
+func (e *waitPermanentError) Error() string { return e.err.Error() }
+func (e *waitPermanentError) Unwrap() error { return e.err }
+
+// permanentWaitError wraps err so that waitFor returns it instead of retrying.
+func permanentWaitError(err error) error {
+	return &waitPermanentError{err: err}
+}
+
+// waitFor calls check until it reports done, a failure state is reached, the
+// timeout expires or ctx is cancelled. It returns the last observed state.
+// Cluster lookups made by check bypass the cluster cache.
+func waitFor(ctx context.Context, check waitCheckFunc, cfg WaitConfig) (string, error) {
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+	// Polling must observe status changes, so never serve checks from the cluster cache.
+	ctx = withFreshClusters(ctx)
+	cfg = cfg.withDefaults()
+
+	var (
+		lastState string
+		lastErr   error
+	)
+	delay := cfg.InitialInterval
+
+	for attempt := 1; ; attempt++ {
+		state, done, err := check(ctx)
+		if cfg.OnProgress != nil {
+			cfg.OnProgress(attempt, state, err)
+		}
+
+		if err != nil {
+			var permanent *waitPermanentError
+			if errors.As(err, &permanent) {
+				return lastState, permanent.err
+			}
+			lastErr = err
+			log.Printf("[WARN] wait check failed (attempt %d): %v", attempt, err)
+		} else {
+			lastState = state
+			lastErr = nil
+			if done {
+				return state, nil
+			}
+			for _, failed := range cfg.FailureStates {
+				if state == failed {
+					return state, fmt.Errorf("reached terminal state %q", state)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && cfg.Timeout > 0 {
+				if lastErr != nil {
+					return lastState, fmt.Errorf("timeout after %s; last known state: %q; last error: %v", cfg.Timeout, lastState, lastErr)
+				}
+				return lastState, fmt.Errorf("timeout after %s; last known state: %q", cfg.Timeout, lastState)
+			}
+			return lastState, ctx.Err()
+		case <-time.After(delay):
+		case <-cfg.Wake:
+		}
+
+		delay = cfg.nextInterval(delay)
+	}
+}
+
+// withDefaults fills in the intervals of cfg that are unset or out of range.
+func (cfg WaitConfig) withDefaults() WaitConfig {
+	if cfg.InitialInterval <= 0 {
+		cfg.InitialInterval = 10 * time.Second
+	}
+	if cfg.MaxInterval < cfg.InitialInterval {
+		cfg.MaxInterval = cfg.InitialInterval
+	}
+	if cfg.BackoffMultiplier < 1 {
+		cfg.BackoffMultiplier = 1
+	}
+	return cfg
+}
+
+// nextInterval returns the delay that follows delay, grown by BackoffMultiplier and
+// capped at MaxInterval.
+func (cfg WaitConfig) nextInterval(delay time.Duration) time.Duration {
+	delay = time.Duration(float64(delay) * cfg.BackoffMultiplier)
+	if delay > cfg.MaxInterval {
+		delay = cfg.MaxInterval
+	}
+	return delay
+}
+
+// sleepContext pauses for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// isInterrupted reports whether err stems from the operation being cancelled,
+// e.g. by Ctrl-C, rather than from a timeout or API failure.
+func isInterrupted(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// interruptedDiags reports an interrupted operation with a hint on how to resume it.
+func interruptedDiags(summary, hint string) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   hint,
+		},
+	}
+}
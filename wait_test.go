@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// checkResult is one scripted outcome of a fake wait check.
+type checkResult struct {
+	state string
+	done  bool
+	err   error
+}
+
+// scriptedCheck returns a check that yields results in order and repeats the last one
+// once the script is exhausted. The returned counter holds the number of calls.
+func scriptedCheck(results ...checkResult) (waitCheckFunc, *int) {
+	calls := 0
+	return func(ctx context.Context) (string, bool, error) {
+		r := results[len(results)-1]
+		if calls < len(results) {
+			r = results[calls]
+		}
+		calls++
+		return r.state, r.done, r.err
+	}, &calls
+}
+
+func TestWaitFor(t *testing.T) {
+	errTransient := errors.New("connection reset")
+	errFatal := errors.New("cluster was deleted")
+
+	fast := WaitConfig{Timeout: time.Second, InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+
+	tests := []struct {
+		name      string
+		cfg       WaitConfig
+		results   []checkResult
+		wantState string
+		wantCalls int
+		wantErr   string
+	}{
+		{
+			name:      "done on first check",
+			cfg:       fast,
+			results:   []checkResult{{state: "Running", done: true}},
+			wantState: "Running",
+			wantCalls: 1,
+		},
+		{
+			name: "done after progressing",
+			cfg:  fast,
+			results: []checkResult{
+				{state: "Progressing"},
+				{state: "Progressing"},
+				{state: "Running", done: true},
+			},
+			wantState: "Running",
+			wantCalls: 3,
+		},
+		{
+			name: "transient errors are retried",
+			cfg:  fast,
+			results: []checkResult{
+				{state: "Progressing"},
+				{err: errTransient},
+				{state: "Running", done: true},
+			},
+			wantState: "Running",
+			wantCalls: 3,
+		},
+		{
+			name: "failure state stops the wait",
+			cfg:  WaitConfig{Timeout: time.Second, InitialInterval: time.Millisecond, FailureStates: []string{"Failed", "Error"}},
+			results: []checkResult{
+				{state: "Progressing"},
+				{state: "Error"},
+			},
+			wantState: "Error",
+			wantCalls: 2,
+			wantErr:   `reached terminal state "Error"`,
+		},
+		{
+			name: "permanent error stops the wait",
+			cfg:  fast,
+			results: []checkResult{
+				{state: "Progressing"},
+				{err: permanentWaitError(errFatal)},
+			},
+			wantState: "Progressing",
+			wantCalls: 2,
+			wantErr:   errFatal.Error(),
+		},
+		{
+			name:      "timeout reports the last state",
+			cfg:       WaitConfig{Timeout: 20 * time.Millisecond, InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond},
+			results:   []checkResult{{state: "Progressing"}},
+			wantState: "Progressing",
+			wantCalls: -1,
+			wantErr:   `timeout after 20ms; last known state: "Progressing"`,
+		},
+		{
+			name: "timeout reports the last error",
+			cfg:  WaitConfig{Timeout: 20 * time.Millisecond, InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond},
+			results: []checkResult{
+				{state: "Progressing"},
+				{err: errTransient},
+			},
+			wantState: "Progressing",
+			wantCalls: -1,
+			wantErr:   `last known state: "Progressing"; last error: connection reset`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check, calls := scriptedCheck(tt.results...)
+			state, err := waitFor(context.Background(), check, tt.cfg)
+
+			if state != tt.wantState {
+				t.Errorf("state = %q, want %q", state, tt.wantState)
+			}
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if tt.wantCalls >= 0 && *calls != tt.wantCalls {
+				t.Errorf("check called %d times, want %d", *calls, tt.wantCalls)
+			}
+			if tt.wantCalls < 0 && *calls < 2 {
+				t.Errorf("check called %d times, want it to be polled until the timeout", *calls)
+			}
+		})
+	}
+}
+
+func TestWaitForPermanentErrorIsUnwrapped(t *testing.T) {
+	errFatal := errors.New("cluster was deleted")
+	check, _ := scriptedCheck(checkResult{err: permanentWaitError(errFatal)})
+
+	_, err := waitFor(context.Background(), check, WaitConfig{InitialInterval: time.Millisecond})
+	if err != errFatal {
+		t.Fatalf("error = %#v, want the wrapped error itself", err)
+	}
+}
+
+func TestWaitForContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	check, calls := scriptedCheck(checkResult{state: "Progressing"})
+	cfg := WaitConfig{
+		Timeout:         time.Minute,
+		InitialInterval: time.Hour,
+		// Cancel while waitFor sleeps before the second check.
+		OnProgress: func(attempt int, state string, err error) { cancel() },
+	}
+
+	start := time.Now()
+	state, err := waitFor(ctx, check, cfg)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("waitFor returned after %s, want it to stop on cancellation", elapsed)
+	}
+	if !isInterrupted(err) {
+		t.Errorf("error = %v, want an interruption", err)
+	}
+	if state != "Progressing" {
+		t.Errorf("state = %q, want %q", state, "Progressing")
+	}
+	if *calls != 1 {
+		t.Errorf("check called %d times, want 1", *calls)
+	}
+}
+
+func TestWaitForOnProgress(t *testing.T) {
+	errTransient := errors.New("connection reset")
+	check, _ := scriptedCheck(
+		checkResult{state: "Pending"},
+		checkResult{err: errTransient},
+		checkResult{state: "Running", done: true},
+	)
+
+	type progress struct {
+		attempt int
+		state   string
+		err     error
+	}
+	var got []progress
+	cfg := WaitConfig{
+		InitialInterval: time.Millisecond,
+		OnProgress: func(attempt int, state string, err error) {
+			got = append(got, progress{attempt, state, err})
+		},
+	}
+	if _, err := waitFor(context.Background(), check, cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []progress{
+		{1, "Pending", nil},
+		{2, "", errTransient},
+		{3, "Running", nil},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("OnProgress called %d times, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("OnProgress call %d = %v, want %v", i+1, got[i], want[i])
+		}
+	}
+}
+
+func TestWaitForWake(t *testing.T) {
+	wake := make(chan struct{}, 1)
+	check, calls := scriptedCheck(
+		checkResult{state: "Progressing"},
+		checkResult{state: "Running", done: true},
+	)
+	cfg := WaitConfig{
+		Timeout:         time.Minute,
+		InitialInterval: time.Hour,
+		Wake:            wake,
+	}
+	wake <- struct{}{}
+
+	start := time.Now()
+	state, err := waitFor(context.Background(), check, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("waitFor returned after %s, want Wake to cut the sleep short", elapsed)
+	}
+	if state != "Running" || *calls != 2 {
+		t.Errorf("state = %q after %d checks, want %q after 2", state, *calls, "Running")
+	}
+}
+
+func TestWaitForBackoffTiming(t *testing.T) {
+	var times []time.Time
+	check := func(ctx context.Context) (string, bool, error) {
+		times = append(times, time.Now())
+		return "Progressing", len(times) == 6, nil
+	}
+	cfg := WaitConfig{
+		Timeout:           10 * time.Second,
+		InitialInterval:   10 * time.Millisecond,
+		MaxInterval:       40 * time.Millisecond,
+		BackoffMultiplier: 2,
+	}
+	if _, err := waitFor(context.Background(), check, cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Delays are 10, 20, 40, 40, 40ms: grown by the multiplier, then capped.
+	want := []time.Duration{10, 20, 40, 40, 40}
+	for i, w := range want {
+		gap := times[i+1].Sub(times[i])
+		if gap < w*time.Millisecond {
+			t.Errorf("delay before check %d = %s, want at least %s", i+2, gap, w*time.Millisecond)
+		}
+	}
+}
+
+func TestWaitConfigBackoff(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  WaitConfig
+		want []time.Duration
+	}{
+		{
+			name: "defaults",
+			cfg:  WaitConfig{},
+			want: []time.Duration{10 * time.Second, 10 * time.Second, 10 * time.Second},
+		},
+		{
+			name: "fixed interval",
+			cfg:  WaitConfig{InitialInterval: 5 * time.Second, BackoffMultiplier: 1},
+			want: []time.Duration{5 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+		{
+			name: "multiplier below one keeps the interval fixed",
+			cfg:  WaitConfig{InitialInterval: 5 * time.Second, MaxInterval: time.Minute, BackoffMultiplier: 0.5},
+			want: []time.Duration{5 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+		{
+			name: "growth capped at MaxInterval",
+			cfg:  WaitConfig{InitialInterval: 2 * time.Second, MaxInterval: 30 * time.Second, BackoffMultiplier: 1.5},
+			want: []time.Duration{
+				2 * time.Second,
+				3 * time.Second,
+				4500 * time.Millisecond,
+				6750 * time.Millisecond,
+				10125 * time.Millisecond,
+				15187500 * time.Microsecond,
+				22781250 * time.Microsecond,
+				30 * time.Second,
+				30 * time.Second,
+			},
+		},
+		{
+			name: "MaxInterval below InitialInterval",
+			cfg:  WaitConfig{InitialInterval: 20 * time.Second, MaxInterval: 5 * time.Second, BackoffMultiplier: 2},
+			want: []time.Duration{20 * time.Second, 20 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.cfg.withDefaults()
+			delay := cfg.InitialInterval
+			for i, want := range tt.want {
+				if delay != want {
+					t.Fatalf("delay %d = %s, want %s", i+1, delay, want)
+				}
+				delay = cfg.nextInterval(delay)
+			}
+		})
+	}
+}
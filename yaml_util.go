@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// validateYAML checks that a string attribute contains parseable YAML.
+func validateYAML(v interface{}, k string) ([]string, []error) {
+	s, ok := v.(string)
+	if !ok || strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var out interface{}
+	if err := yaml.Unmarshal([]byte(s), &out); err != nil {
+		return nil, []error{fmt.Errorf("%q must be valid YAML: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// yamlEquivalent reports whether two YAML documents decode to the same value.
+func yamlEquivalent(a, b string) bool {
+	if strings.TrimSpace(a) == "" && strings.TrimSpace(b) == "" {
+		return true
+	}
+	var av, bv interface{}
+	if err := yaml.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := yaml.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// suppressEquivalentYAMLDiff ignores formatting-only changes (key order,
+// indentation, comments) in YAML string attributes.
+func suppressEquivalentYAMLDiff(k, old, new string, d *schema.ResourceData) bool {
+	return yamlEquivalent(old, new)
+}
+
+// helmSetValue is a single --set style override applied on top of Helm values.
+type helmSetValue struct {
+	Name  string
+	Value string
+	Type  string // "auto" parses the value as a YAML scalar, "string" keeps it verbatim
+}
+
+// applyHelmSetValues merges set overrides into a YAML values document and returns the result.
+// Names use Helm's dotted path syntax; a literal dot is written as "\.".
+func applyHelmSetValues(values string, sets []helmSetValue) (string, error) {
+	if len(sets) == 0 {
+		return values, nil
+	}
+
+	root := map[string]interface{}{}
+	if strings.TrimSpace(values) != "" {
+		if err := yaml.Unmarshal([]byte(values), &root); err != nil {
+			return "", fmt.Errorf("failed to parse values: %w", err)
+		}
+		if root == nil {
+			root = map[string]interface{}{}
+		}
+	}
+
+	for _, set := range sets {
+		path := splitHelmSetPath(set.Name)
+		if len(path) == 0 {
+			return "", fmt.Errorf("invalid set name %q", set.Name)
+		}
+
+		var value interface{} = set.Value
+		if set.Type != "string" {
+			var parsed interface{}
+			if err := yaml.Unmarshal([]byte(set.Value), &parsed); err == nil && parsed != nil {
+				value = parsed
+			}
+		}
+
+		node := root
+		for _, key := range path[:len(path)-1] {
+			child, ok := node[key].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[key] = child
+			}
+			node = child
+		}
+		node[path[len(path)-1]] = value
+	}
+
+	out, err := yaml.Marshal(root)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode values: %w", err)
+	}
+	return string(out), nil
+}
+
+// splitHelmSetPath splits a dotted set name on unescaped dots.
+func splitHelmSetPath(name string) []string {
+	var (
+		parts   []string
+		current strings.Builder
+	)
+	for i := 0; i < len(name); i++ {
+		switch {
+		case name[i] == '\\' && i+1 < len(name) && name[i+1] == '.':
+			current.WriteByte('.')
+			i++
+		case name[i] == '.':
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(name[i])
+		}
+	}
+	parts = append(parts, current.String())
+
+	for _, p := range parts {
+		if p == "" {
+			return nil
+		}
+	}
+	return parts
+}
+
+// mergeYAMLDocuments deep-merges YAML mapping documents in order, later documents
+// overriding earlier ones. Nested mappings are merged key by key; any other value,
+// including lists, replaces the earlier one, as Helm does for multiple values files.
+func mergeYAMLDocuments(docs []string) (string, error) {
+	var nonEmpty []string
+	for _, doc := range docs {
+		if strings.TrimSpace(doc) != "" {
+			nonEmpty = append(nonEmpty, doc)
+		}
+	}
+	switch len(nonEmpty) {
+	case 0:
+		return "", nil
+	case 1:
+		return nonEmpty[0], nil
+	}
+
+	merged := map[string]interface{}{}
+	for i, doc := range nonEmpty {
+		var m map[string]interface{}
+		if err := yaml.Unmarshal([]byte(doc), &m); err != nil {
+			return "", fmt.Errorf("failed to parse values document %d: %w", i, err)
+		}
+		mergeYAMLMaps(merged, m)
+	}
+
+	out, err := yaml.Marshal(merged)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode merged values: %w", err)
+	}
+	return string(out), nil
+}
+
+// mergeYAMLMaps merges src into dst recursively.
+func mergeYAMLMaps(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeYAMLMaps(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// renderValuesTemplate reads a values file and renders it as a Go template with vars
+// as its data, e.g. {{ .replicas }}. Referencing a variable missing from vars is an
+// error rather than rendering "<no value>", and the output must be valid YAML.
+func renderValuesTemplate(path string, vars map[string]string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read values template %s: %w", path, err)
+	}
+
+	tmpl, err := template.New(path).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse values template %s: %w", path, err)
+	}
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, vars); err != nil {
+		return "", fmt.Errorf("failed to render values template %s: %w", path, err)
+	}
+
+	var parsed interface{}
+	if err := yaml.Unmarshal(out.Bytes(), &parsed); err != nil {
+		return "", fmt.Errorf("values template %s did not render to valid YAML: %w", path, err)
+	}
+	return out.String(), nil
+}